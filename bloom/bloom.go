@@ -1,6 +1,7 @@
 package bloom
 
 import (
+	"github.com/dairongpeng/ds/set/bitset"
 	"hash/fnv"
 	"math"
 )
@@ -8,7 +9,9 @@ import (
 // Filter 布隆过滤器
 type Filter struct {
 	// 位图
-	arr []uint32
+	bits *bitset.BitSet
+	// 位图的位数
+	m uint32
 	// hash函数列表
 	hashFs []func(data []byte) uint32
 	// 失误率
@@ -30,14 +33,6 @@ func NewFilter(n int, p float64) *Filter {
 	upM := int(math.Ceil(m))
 	upK := int(math.Ceil(k))
 
-	var size int
-	block := upM / 32
-	if int32(upM)%32 == 0 {
-		size = block
-	} else {
-		size = block + 1
-	}
-
 	// 加工upK个hash函数，作为对比的指纹
 	hashFs := make([]func(data []byte) uint32, upK)
 	for i := 0; i < upK; i++ {
@@ -47,7 +42,8 @@ func NewFilter(n int, p float64) *Filter {
 	// 真实失误率
 	q := math.Pow(1-math.Exp(-float64(upK)*float64(n)/float64(upM)), float64(upK))
 	f := &Filter{
-		arr:    make([]uint32, size),
+		bits:   bitset.New(uint(upM)),
+		m:      uint32(upM),
 		hashFs: hashFs,
 		p:      q,
 	}
@@ -73,10 +69,7 @@ func (bf *Filter) P() float64 {
 func (bf *Filter) Add(element string) {
 	for _, hf := range bf.hashFs {
 		// 每个指纹描摹
-		arrIx := hf([]byte(element)) % uint32(len(bf.arr))
-		blockIx := hf([]byte(element)) % 32
-
-		bf.arr[arrIx] = bf.arr[arrIx] | (1 << blockIx)
+		bf.bits.Set(uint(hf([]byte(element)) % bf.m))
 	}
 }
 
@@ -84,11 +77,7 @@ func (bf *Filter) Add(element string) {
 func (bf *Filter) Contains(element string) bool {
 	for _, hf := range bf.hashFs {
 		// 每个指纹对比
-		arrIx := hf([]byte(element)) % uint32(len(bf.arr))
-		blockIx := hf([]byte(element)) % 32
-
-		ix := bf.arr[arrIx] >> blockIx
-		if ix&1 == 0 {
+		if !bf.bits.Test(uint(hf([]byte(element)) % bf.m)) {
 			return false
 		}
 	}
@@ -18,7 +18,7 @@ func TestNewFilter(t *testing.T) {
 	// 100万数据量：1000000 预期失误率控制在万分之一以下为：0.0001
 	n := 1000000
 	p := 0.0001
-	bf := NewFilter(n, p) // 真实失误率：0.0001007858789369095 k=14 len(arr)=599067
+	bf := NewFilter(n, p) // 真实失误率：0.0001007858789369095 k=14 m=19170117
 
 	elements := []string{"apple", "banana", "cherry"}
 	// 将元素添加到布隆过滤器中
@@ -101,7 +101,7 @@ func (g *Graph[T]) PrimMST(comparator pkg.Comparator[*Edge[T]]) map[*Edge[T]]str
 						// 没加过的，放入小根堆，并标记为已经处理过
 						if _, ok := edgeSet[nextEdge]; !ok {
 							edgeSet[nextEdge] = ""
-							_ = edgesMinHeap.Push(edge)
+							_ = edgesMinHeap.Push(nextEdge)
 						}
 					}
 				}
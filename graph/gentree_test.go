@@ -0,0 +1,50 @@
+package graph
+
+import (
+	"testing"
+)
+
+// addUndirectedEdge 无向边用两条方向相反的有向边表示
+func addUndirectedEdge[T int | int64 | float64 | string | *interface{}](g *Graph[T], a, b *Node[T], weight int) {
+	g.AddEdge(a, b, weight)
+	g.AddEdge(b, a, weight)
+}
+
+func edgeComparator(a, b *Edge[string]) int {
+	return a.weight - b.weight
+}
+
+func TestMST(t *testing.T) {
+	type testCase struct {
+		name string
+		mst  func(g *Graph[string]) map[*Edge[string]]string
+	}
+	tests := []testCase{
+		{name: "kruskal", mst: func(g *Graph[string]) map[*Edge[string]]string { return g.KruskalMST(edgeComparator) }},
+		{name: "prim", mst: func(g *Graph[string]) map[*Edge[string]]string { return g.PrimMST(edgeComparator) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// A-B-C-D 是最小生成树，A-C和D-A是多余的重边
+			g := NewGraph[string]()
+			A := g.AddNode("A")
+			B := g.AddNode("B")
+			C := g.AddNode("C")
+			D := g.AddNode("D")
+			addUndirectedEdge(g, A, B, 1)
+			addUndirectedEdge(g, B, C, 2)
+			addUndirectedEdge(g, C, D, 3)
+			addUndirectedEdge(g, A, C, 5)
+			addUndirectedEdge(g, D, A, 10)
+
+			result := tt.mst(g)
+			weight := 0
+			for edge := range result {
+				weight += edge.weight
+			}
+			if len(result) != 3 || weight != 6 {
+				t.Errorf("got %d edges with weight %d, want 3 edges with weight 6", len(result), weight)
+			}
+		})
+	}
+}
@@ -3,7 +3,8 @@ package graph
 /** 图的遍历算法 **/
 
 import (
-	"github.com/dairongpeng/ds/queue/arrayqueue"
+	"github.com/dairongpeng/ds/queue/ringqueue"
+	"github.com/dairongpeng/ds/set/hashset"
 	"github.com/dairongpeng/ds/stack/arraystack"
 )
 
@@ -15,12 +16,12 @@ func (node *Node[T]) Bfs() []T {
 	}
 	bfsorder := make([]T, 0)
 
-	queue := arrayqueue.New[*Node[T]]()
+	queue := ringqueue.New[*Node[T]]()
 	// 图需要用set结构，因为图相比于二叉树有可能存在环
 	// 即有可能存在某个点多次进入队列的情况。使用Set可以防止相同节点重复进入队列
-	Set := make(map[*Node[T]]string, 0)
+	set := hashset.New[*Node[T]]()
 	queue.Enqueue(node)
-	Set[node] = ""
+	set.Add(node)
 
 	for !queue.IsEmpty() {
 		// 出队列
@@ -30,8 +31,8 @@ func (node *Node[T]) Bfs() []T {
 		for _, next := range cur.nexts {
 			// 直接邻居，没有进入过Set的进入Set和队列
 			// 用set限制队列的元素，防止有环队列一直会加入元素
-			if _, ok := Set[next]; !ok { // Set中不存在, 则加入队列
-				Set[next] = ""
+			if !set.Contains(next) { // Set中不存在, 则加入队列
+				set.Add(next)
 				queue.Enqueue(next)
 			}
 		}
@@ -50,10 +51,10 @@ func (node *Node[T]) Dfs() []T {
 
 	stack := arraystack.New[*Node[T]]()
 	// Set的作用和宽度优先遍历类似，保证重复的点不要进栈
-	set := make(map[*Node[T]]string, 0)
+	set := hashset.New[*Node[T]]()
 	// 进栈
 	stack.Push(node)
-	set[node] = ""
+	set.Add(node)
 	// 收集的时机是在进栈的时候
 	dfsorder = append(dfsorder, node.value)
 
@@ -63,12 +64,12 @@ func (node *Node[T]) Dfs() []T {
 		// 枚举当前弹出节点的后代
 		for _, next := range cur.nexts {
 			// 只要某个后代没进入过栈，进栈
-			if _, ok := set[next]; !ok {
+			if !set.Contains(next) {
 				// 把该节点的父亲节点重新压回栈中
 				stack.Push(cur)
 				// 再把自己压入栈中
 				stack.Push(next)
-				set[next] = ""
+				set.Add(next)
 				// 收集当前节点的值
 				dfsorder = append(dfsorder, next.value)
 				// 直接break，此时栈顶是当前next节点，达到深度优先的目的
@@ -85,7 +86,7 @@ func (g *Graph[T]) Topology() []*Node[T] {
 	// 提取入度信息：节点->入度
 	inMap := make(map[*Node[T]]int)
 	// 提取入度为零的节点信息，剩余入度为0的点，才能进这个队列
-	zeroInQueue := arrayqueue.New[*Node[T]]()
+	zeroInQueue := ringqueue.New[*Node[T]]()
 	// 拿到该图中所有的点集
 	for _, node := range g.nodes {
 		// 初始化每个点，每个点的入度是原始节点的入度信息
@@ -17,7 +17,7 @@ type MaxHeap[T any] struct {
 	heapSize int
 }
 
-// NewMaxHeap 初始化一个大根堆结构
+// NewMaxHeap 初始化一个大根堆结构，limit为堆的容量，limit小于等于0时不限制容量
 func NewMaxHeap[T any](limit int, comparator pkg.Comparator[T]) *MaxHeap[T] {
 	maxHeap := &MaxHeap[T]{
 		heap:     make([]T, 0),
@@ -28,28 +28,58 @@ func NewMaxHeap[T any](limit int, comparator pkg.Comparator[T]) *MaxHeap[T] {
 	return maxHeap
 }
 
+// IsEmpty 判断堆是否为空
 func (h *MaxHeap[T]) IsEmpty() bool {
-	return len(h.heap) == 0
+	return h.heapSize == 0
 }
 
+// IsFull 判断堆是否已满，不限制容量的堆永远不会满
 func (h *MaxHeap[T]) IsFull() bool {
-	return h.heapSize == h.limit
+	return h.limit > 0 && h.heapSize >= h.limit
 }
 
+// Size 返回堆中元素的个数
+func (h *MaxHeap[T]) Size() int {
+	return h.heapSize
+}
+
+// Push 添加一个元素，堆已满时返回错误
 func (h *MaxHeap[T]) Push(value T) error {
-	if h.heapSize == h.limit {
+	if h.IsFull() {
 		return errors.New("heap is full")
 	}
 
-	h.heap[h.heapSize] = value
 	// heapSize的位置保存当前value
+	h.heap = append(h.heap[:h.heapSize], value)
 	up(h.heap, h.heapSize, h.cmp)
 	h.heapSize++
 	return nil
 }
 
-// Pop 返回堆中的最大值，并且在大根堆中，把最大值删掉。弹出后依然保持大根堆的结构
+// Peek 返回堆顶元素，即堆中的最大值，不弹出。如果堆为空则返回一个零值和false
+func (h *MaxHeap[T]) Peek() (T, bool) {
+	if h.heapSize == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return h.heap[0], true
+}
+
+// Pop 返回堆中的最大值，并且在大根堆中，把最大值删掉。弹出后依然保持大根堆的结构。堆为空时panic，不确定是否为空时使用TryPop
 func (h *MaxHeap[T]) Pop() T {
+	v, ok := h.TryPop()
+	if !ok {
+		panic("heap is empty")
+	}
+	return v
+}
+
+// TryPop 弹出并返回堆中的最大值，如果堆为空则返回一个零值和false
+func (h *MaxHeap[T]) TryPop() (T, bool) {
+	var zeroValue T
+	if h.heapSize == 0 {
+		return zeroValue, false
+	}
 	// 弹出堆顶元素的实现为
 	// 1. 交换堆顶和队列末尾元素。
 	// 2. 堆大小减一
@@ -57,8 +87,11 @@ func (h *MaxHeap[T]) Pop() T {
 	tmp := h.heap[0]
 	h.heapSize--
 	h.heap[0], h.heap[h.heapSize] = h.heap[h.heapSize], h.heap[0]
+	// 清除引用，避免内存泄漏
+	h.heap[h.heapSize] = zeroValue
+	h.heap = h.heap[:h.heapSize]
 	down(h.heap, 0, h.heapSize, h.cmp)
-	return tmp
+	return tmp, true
 }
 
 // 往堆上添加数，需要从当前位置找父节点比较。实质上是从数组的末尾添加节点，往整个树根节点方向去PK
@@ -0,0 +1,35 @@
+package maxheap
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"testing"
+)
+
+func TestMaxHeap(t *testing.T) {
+	h := NewMaxHeap[int](0, pkg.NumberComparator[int])
+	for _, v := range []int{5, 3, 8, 1, 9, 2} {
+		if err := h.Push(v); err != nil {
+			t.Fatalf("Push(%d) error = %v", v, err)
+		}
+	}
+	if h.IsFull() || h.Size() != 6 {
+		t.Fatalf("IsFull() = %v, Size() = %d", h.IsFull(), h.Size())
+	}
+	if v := h.Pop(); v != 9 {
+		t.Fatalf("Pop() = %d, want 9", v)
+	}
+	for _, want := range []int{8, 5, 3, 2, 1} {
+		if v, ok := h.TryPop(); !ok || v != want {
+			t.Fatalf("TryPop() = %d, %v, want %d", v, ok, want)
+		}
+	}
+	if _, ok := h.TryPop(); ok || !h.IsEmpty() {
+		t.Errorf("heap should be empty")
+	}
+
+	limited := NewMaxHeap[int](1, pkg.NumberComparator[int])
+	_ = limited.Push(1)
+	if !limited.IsFull() || limited.Push(2) == nil {
+		t.Errorf("Push() on full heap should fail")
+	}
+}
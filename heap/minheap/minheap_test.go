@@ -0,0 +1,61 @@
+package minheap
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"testing"
+)
+
+func TestMinHeap(t *testing.T) {
+	type testCase struct {
+		name  string
+		limit int
+		// 能够成功压入的元素个数
+		pushed int
+	}
+	values := []int{5, 3, 8, 1, 9, 2}
+	tests := []testCase{
+		{name: "limited", limit: 4, pushed: 4},
+		{name: "unlimited", limit: 0, pushed: 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewMaxHeap[int](tt.limit, pkg.NumberComparator[int])
+			if !h.IsEmpty() {
+				t.Fatalf("new heap should be empty")
+			}
+			if _, ok := h.TryPop(); ok {
+				t.Fatalf("TryPop() on empty heap")
+			}
+			pushed := 0
+			for _, v := range values {
+				if h.Push(v) == nil {
+					pushed++
+				}
+			}
+			if pushed != tt.pushed || h.Size() != tt.pushed {
+				t.Fatalf("pushed %d, Size() = %d, want %d", pushed, h.Size(), tt.pushed)
+			}
+			prev := -1
+			for !h.IsEmpty() {
+				top, _ := h.Peek()
+				v := h.Pop()
+				if v != top || v < prev {
+					t.Fatalf("Pop() = %d after %d, Peek() = %d", v, prev, top)
+				}
+				prev = v
+			}
+			if _, ok := h.Peek(); ok {
+				t.Errorf("Peek() on empty heap")
+			}
+		})
+	}
+}
+
+func TestMinHeap_PopEmpty(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Pop() on empty heap should panic")
+		}
+	}()
+	NewMaxHeap[int](0, pkg.NumberComparator[int]).Pop()
+}
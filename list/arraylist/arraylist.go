@@ -0,0 +1,202 @@
+package arraylist
+
+import (
+	"fmt"
+	dslist "github.com/dairongpeng/ds/list"
+	"github.com/dairongpeng/ds/pkg"
+	"github.com/dairongpeng/ds/sort/mergesort"
+)
+
+// 最小容量，容量不会收缩到该值以下
+const minCapacity = 16
+
+// List 动态数组实现的线性表，尾部追加摊还O(1)，按下标访问O(1)
+// 元素个数不足容量的1/4时容量减半，避免大量删除后长期占用内存
+type List[T any] struct {
+	elements []T
+}
+
+// New 初始化一个动态数组，values依次追加到尾部
+func New[T any](values ...T) *List[T] {
+	l := &List[T]{}
+	if len(values) > 0 {
+		l.elements = make([]T, len(values))
+		copy(l.elements, values)
+	}
+	return l
+}
+
+// Add 添加一个元素到数组尾部
+func (l *List[T]) Add(v T) {
+	l.elements = append(l.elements, v)
+}
+
+// Remove 从数组尾部移出一个元素，即最后添加的元素
+func (l *List[T]) Remove() (T, bool) {
+	return l.RemoveAt(len(l.elements) - 1)
+}
+
+// Get 通过下标获取数组中的元素
+func (l *List[T]) Get(index int) (T, bool) {
+	if !l.inRange(index) {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return l.elements[index], true
+}
+
+// Set 修改下标为index的元素，下标越界时返回false
+func (l *List[T]) Set(index int, v T) bool {
+	if !l.inRange(index) {
+		return false
+	}
+	l.elements[index] = v
+	return true
+}
+
+// Insert 在下标index处依次插入values，原来index及之后的元素后移
+// index等于元素个数时相当于追加到尾部，下标越界时返回false
+func (l *List[T]) Insert(index int, values ...T) bool {
+	if index < 0 || index > len(l.elements) {
+		return false
+	}
+	if len(values) == 0 {
+		return true
+	}
+	n := len(l.elements)
+	// 先扩展长度，再把index之后的元素整体后移
+	l.elements = append(l.elements, values...)
+	copy(l.elements[index+len(values):], l.elements[index:n])
+	copy(l.elements[index:], values)
+	return true
+}
+
+// RemoveAt 删除并返回下标为index的元素，之后的元素前移。下标越界时返回一个零值和false
+func (l *List[T]) RemoveAt(index int) (T, bool) {
+	var zeroValue T
+	if !l.inRange(index) {
+		return zeroValue, false
+	}
+	v := l.elements[index]
+	last := len(l.elements) - 1
+	copy(l.elements[index:], l.elements[index+1:])
+	// 清除引用，避免内存泄漏
+	l.elements[last] = zeroValue
+	l.elements = l.elements[:last]
+	l.shrink()
+	return v, true
+}
+
+// Swap 交换下标i和j的元素，下标越界时返回false
+func (l *List[T]) Swap(i, j int) bool {
+	if !l.inRange(i) || !l.inRange(j) {
+		return false
+	}
+	l.elements[i], l.elements[j] = l.elements[j], l.elements[i]
+	return true
+}
+
+// IndexOf 返回第一个与v相等的元素下标，不存在时返回-1
+func (l *List[T]) IndexOf(v T, cmp pkg.Comparator[T]) int {
+	for i, e := range l.elements {
+		if cmp(e, v) == 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// Contains 判断是否存在与v相等的元素
+func (l *List[T]) Contains(v T, cmp pkg.Comparator[T]) bool {
+	return l.IndexOf(v, cmp) >= 0
+}
+
+// Sort 使用归并排序对数组原地排序，排序是稳定的
+func (l *List[T]) Sort(cmp func(item1, item2 any) int) {
+	mergesort.NewMergeSorter(l.elements).Sort(cmp)
+}
+
+// Size 返回元素个数
+func (l *List[T]) Size() int {
+	return len(l.elements)
+}
+
+// IsEmpty 判断数组是否为空
+func (l *List[T]) IsEmpty() bool {
+	return len(l.elements) == 0
+}
+
+// Clear 清空数组，释放底层数组
+func (l *List[T]) Clear() {
+	l.elements = nil
+}
+
+// Values 按下标顺序返回所有元素的副本
+func (l *List[T]) Values() []T {
+	values := make([]T, len(l.elements))
+	copy(values, l.elements)
+	return values
+}
+
+// Each 按下标顺序遍历所有元素，f返回false时提前终止遍历
+func (l *List[T]) Each(f func(index int, v T) bool) {
+	for i, v := range l.elements {
+		if !f(i, v) {
+			return
+		}
+	}
+}
+
+// Iterator 返回按下标顺序访问元素的迭代器
+func (l *List[T]) Iterator() dslist.Iterator[T] {
+	return &Iterator[T]{list: l, index: -1}
+}
+
+// Iterator 动态数组的迭代器，迭代期间不能插入或删除元素
+type Iterator[T any] struct {
+	list  *List[T]
+	index int
+}
+
+// Next 移动到下一个元素，没有更多元素时返回false
+func (it *Iterator[T]) Next() bool {
+	if it.index+1 >= len(it.list.elements) {
+		return false
+	}
+	it.index++
+	return true
+}
+
+// Value 返回当前元素
+func (it *Iterator[T]) Value() T {
+	return it.list.elements[it.index]
+}
+
+// Capacity 返回底层数组的容量
+func (l *List[T]) Capacity() int {
+	return cap(l.elements)
+}
+
+// Print 打印数组
+func (l *List[T]) Print() {
+	fmt.Println("Array List: ")
+	for _, v := range l.elements {
+		fmt.Print(v, " ")
+	}
+	fmt.Println()
+}
+
+func (l *List[T]) inRange(index int) bool {
+	return index >= 0 && index < len(l.elements)
+}
+
+// shrink 元素个数不足容量的1/4时把容量减半
+func (l *List[T]) shrink() {
+	c := cap(l.elements)
+	if c <= minCapacity || len(l.elements) > c/4 {
+		return
+	}
+	elements := make([]T, len(l.elements), c/2)
+	copy(elements, l.elements)
+	l.elements = elements
+}
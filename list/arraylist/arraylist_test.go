@@ -0,0 +1,98 @@
+package arraylist
+
+import (
+	dslist "github.com/dairongpeng/ds/list"
+	"github.com/dairongpeng/ds/pkg"
+	"reflect"
+	"testing"
+)
+
+func TestList_Insert(t *testing.T) {
+	type testCase struct {
+		name   string
+		index  int
+		values []int
+		ok     bool
+		want   []int
+	}
+	tests := []testCase{
+		{name: "head", index: 0, values: []int{8, 9}, ok: true, want: []int{8, 9, 1, 2, 3}},
+		{name: "middle", index: 1, values: []int{8}, ok: true, want: []int{1, 8, 2, 3}},
+		{name: "tail", index: 3, values: []int{8, 9}, ok: true, want: []int{1, 2, 3, 8, 9}},
+		{name: "empty_values", index: 2, ok: true, want: []int{1, 2, 3}},
+		{name: "out_of_range", index: 4, values: []int{8}, ok: false, want: []int{1, 2, 3}},
+		{name: "negative", index: -1, values: []int{8}, ok: false, want: []int{1, 2, 3}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New[int](1, 2, 3)
+			if ok := l.Insert(tt.index, tt.values...); ok != tt.ok {
+				t.Errorf("Insert() = %v, want %v", ok, tt.ok)
+			}
+			if !reflect.DeepEqual(l.elements, tt.want) {
+				t.Errorf("elements = %v, want %v", l.elements, tt.want)
+			}
+		})
+	}
+}
+
+func TestList_Access(t *testing.T) {
+	var dl dslist.DSList[int] = New[int]()
+	for i := 0; i < 5; i++ {
+		dl.Add(i)
+	}
+	l := dl.(*List[int])
+
+	if v, ok := l.RemoveAt(1); !ok || v != 1 {
+		t.Errorf("RemoveAt(1) = %d, %v", v, ok)
+	}
+	if v, ok := l.Remove(); !ok || v != 4 {
+		t.Errorf("Remove() = %d, %v", v, ok)
+	}
+	l.Set(0, 7)
+	l.Swap(0, 2)
+	if !reflect.DeepEqual(l.elements, []int{3, 2, 7}) {
+		t.Errorf("elements = %v", l.elements)
+	}
+	if l.Set(3, 0) || l.Swap(0, 3) {
+		t.Errorf("Set()/Swap() out of range")
+	}
+	if _, ok := l.Get(3); ok {
+		t.Errorf("Get(3) out of range")
+	}
+	if i := l.IndexOf(7, pkg.NumberComparator[int]); i != 2 {
+		t.Errorf("IndexOf(7) = %d", i)
+	}
+	if i := l.IndexOf(1, pkg.NumberComparator[int]); i != -1 {
+		t.Errorf("IndexOf(1) = %d", i)
+	}
+}
+
+func TestList_Sort(t *testing.T) {
+	l := New[int](5, 1, 4, 2, 3)
+	l.Sort(func(a, b any) int {
+		return a.(int) - b.(int)
+	})
+	if !reflect.DeepEqual(l.elements, []int{1, 2, 3, 4, 5}) {
+		t.Errorf("elements = %v", l.elements)
+	}
+}
+
+func TestList_Shrink(t *testing.T) {
+	l := New[int]()
+	for i := 0; i < 1000; i++ {
+		l.Add(i)
+	}
+	grown := l.Capacity()
+	for i := 0; i < 990; i++ {
+		l.Remove()
+	}
+	if l.Capacity() >= grown || l.Capacity() < minCapacity {
+		t.Errorf("Capacity() = %d, grown %d", l.Capacity(), grown)
+	}
+	for i := 0; i < 10; i++ {
+		if v, _ := l.Get(i); v != i {
+			t.Errorf("Get(%d) = %d", i, v)
+		}
+	}
+}
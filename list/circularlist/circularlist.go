@@ -0,0 +1,341 @@
+package circularlist
+
+import (
+	"fmt"
+	dslist "github.com/dairongpeng/ds/list"
+	"github.com/dairongpeng/ds/pkg"
+)
+
+// Node 环形链表节点，尾节点的下一个节点是头节点
+type Node[T any] struct {
+	Value T
+	next  *Node[T]
+	prev  *Node[T]
+	// 节点所属的链表，节点被删除后置为nil
+	list *List[T]
+}
+
+// Next 返回下一个节点，在尾节点上返回头节点。节点已被删除时返回nil
+func (n *Node[T]) Next() *Node[T] {
+	return n.next
+}
+
+// Prev 返回上一个节点，在头节点上返回尾节点。节点已被删除时返回nil
+func (n *Node[T]) Prev() *Node[T] {
+	return n.prev
+}
+
+// List 环形双向链表，只维护头节点，尾节点即head.prev
+// 链表首尾相连，适合轮询调度一类需要循环访问的场景
+type List[T any] struct {
+	head *Node[T]
+	size int
+}
+
+// New 初始化一个环形链表，values依次追加到链表尾部
+func New[T any](values ...T) *List[T] {
+	l := &List[T]{}
+	for _, v := range values {
+		l.Add(v)
+	}
+	return l
+}
+
+// Head 返回头节点，链表为空时返回nil
+func (l *List[T]) Head() *Node[T] {
+	return l.head
+}
+
+// Add 添加一个元素到链表尾部，即头节点之前
+func (l *List[T]) Add(v T) {
+	n := &Node[T]{Value: v, list: l}
+	if l.head == nil {
+		n.next = n
+		n.prev = n
+		l.head = n
+	} else {
+		linkBefore(n, l.head)
+	}
+	l.size++
+}
+
+// Remove 移出尾部元素，即头节点之前、最后添加的元素
+func (l *List[T]) Remove() (T, bool) {
+	if l.head == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return l.RemoveNode(l.head.prev)
+}
+
+// RemoveNode 删除节点n，返回节点的值。删除头节点时下一个节点成为头节点
+func (l *List[T]) RemoveNode(n *Node[T]) (T, bool) {
+	if n == nil || n.list != l {
+		var zeroValue T
+		return zeroValue, false
+	}
+	if l.size == 1 {
+		l.head = nil
+	} else {
+		if n == l.head {
+			l.head = n.next
+		}
+		n.prev.next = n.next
+		n.next.prev = n.prev
+	}
+	n.next, n.prev, n.list = nil, nil, nil
+	l.size--
+	return n.Value, true
+}
+
+// Get 通过下标获取元素，下标从头节点开始计算
+func (l *List[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= l.size {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return l.Step(l.head, index).Value, true
+}
+
+// Insert 在下标index处依次插入values，index等于元素个数时追加到尾部，下标越界时返回false
+// 在下标0处插入时，第一个插入的元素成为新的头节点
+func (l *List[T]) Insert(index int, values ...T) bool {
+	if index < 0 || index > l.size {
+		return false
+	}
+	if len(values) == 0 {
+		return true
+	}
+	if index == l.size {
+		for _, v := range values {
+			l.Add(v)
+		}
+		return true
+	}
+	mark := l.Step(l.head, index)
+	var first *Node[T]
+	for _, v := range values {
+		n := &Node[T]{Value: v, list: l}
+		linkBefore(n, mark)
+		l.size++
+		if first == nil {
+			first = n
+		}
+	}
+	if index == 0 {
+		l.head = first
+	}
+	return true
+}
+
+// Set 修改下标为index的元素，下标越界时返回false
+func (l *List[T]) Set(index int, v T) bool {
+	if index < 0 || index >= l.size {
+		return false
+	}
+	l.Step(l.head, index).Value = v
+	return true
+}
+
+// Size 返回元素个数
+func (l *List[T]) Size() int {
+	return l.size
+}
+
+// IsEmpty 判断链表是否为空
+func (l *List[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Clear 清空链表
+func (l *List[T]) Clear() {
+	cur := l.head
+	for i := 0; i < l.size; i++ {
+		next := cur.next
+		cur.next, cur.prev, cur.list = nil, nil, nil
+		cur = next
+	}
+	l.head = nil
+	l.size = 0
+}
+
+// IndexOf 返回从头节点开始第一个与v相等的元素下标，不存在时返回-1
+func (l *List[T]) IndexOf(v T, cmp pkg.Comparator[T]) int {
+	cur := l.head
+	for i := 0; i < l.size; i++ {
+		if cmp(cur.Value, v) == 0 {
+			return i
+		}
+		cur = cur.next
+	}
+	return -1
+}
+
+// Contains 判断是否存在与v相等的元素
+func (l *List[T]) Contains(v T, cmp pkg.Comparator[T]) bool {
+	return l.IndexOf(v, cmp) >= 0
+}
+
+// Values 从头节点开始绕一圈返回所有元素
+func (l *List[T]) Values() []T {
+	values := make([]T, 0, l.size)
+	cur := l.head
+	for i := 0; i < l.size; i++ {
+		values = append(values, cur.Value)
+		cur = cur.next
+	}
+	return values
+}
+
+// Each 从头节点开始绕一圈遍历所有元素，f返回false时提前终止遍历
+func (l *List[T]) Each(f func(index int, v T) bool) {
+	cur := l.head
+	for i := 0; i < l.size; i++ {
+		if !f(i, cur.Value) {
+			return
+		}
+		cur = cur.next
+	}
+}
+
+// Iterator 返回从头节点开始绕一圈访问元素的迭代器
+func (l *List[T]) Iterator() dslist.Iterator[T] {
+	return &Iterator[T]{next: l.head, remaining: l.size}
+}
+
+// Rotate 把头节点向后移动k个位置，k为负数时向前移动。元素的环形顺序不变
+func (l *List[T]) Rotate(k int) {
+	if l.head == nil {
+		return
+	}
+	l.head = l.Step(l.head, k)
+}
+
+// Step 返回从from开始走k步到达的节点，k为负数时向前走。越过首尾时绕回，步数按链表长度取模
+func (l *List[T]) Step(from *Node[T], k int) *Node[T] {
+	if from == nil || from.list != l {
+		return nil
+	}
+	k %= l.size
+	if k < 0 {
+		k += l.size
+	}
+	// 选择较短的方向
+	if k <= l.size/2 {
+		for ; k > 0; k-- {
+			from = from.next
+		}
+	} else {
+		for k = l.size - k; k > 0; k-- {
+			from = from.prev
+		}
+	}
+	return from
+}
+
+// Split 把链表从中间拆分为两个环形链表，前一半包含向上取整的一半元素。拆分后原链表为空
+func (l *List[T]) Split() (*List[T], *List[T]) {
+	first, second := &List[T]{}, &List[T]{}
+	if l.head == nil {
+		return first, second
+	}
+
+	firstSize := (l.size + 1) / 2
+	first.head, first.size = l.head, firstSize
+	second.size = l.size - firstSize
+
+	cur := l.head
+	for i := 0; i < firstSize; i++ {
+		cur.list = first
+		cur = cur.next
+	}
+	if second.size > 0 {
+		second.head = cur
+		for i := 0; i < second.size; i++ {
+			cur.list = second
+			cur = cur.next
+		}
+		// 两段分别首尾相连
+		firstTail, secondTail := second.head.prev, first.head.prev
+		firstTail.next = first.head
+		first.head.prev = firstTail
+		secondTail.next = second.head
+		second.head.prev = secondTail
+	}
+
+	l.head = nil
+	l.size = 0
+	return first, second
+}
+
+// Josephus 约瑟夫环淘汰。从头节点开始报数，每报到第k个就删除该节点，下一个节点重新从1开始报数
+// 返回元素被淘汰的顺序，最后一个即为幸存者。执行后链表为空
+func (l *List[T]) Josephus(k int) []T {
+	if k < 1 {
+		return nil
+	}
+	order := make([]T, 0, l.size)
+	cur := l.head
+	for l.size > 0 {
+		cur = l.Step(cur, k-1)
+		next := cur.next
+		v, _ := l.RemoveNode(cur)
+		order = append(order, v)
+		cur = next
+	}
+	return order
+}
+
+// JosephusSurvivor 返回n个人围成一圈、每报到第k个淘汰一人时，幸存者的下标(从0开始)
+// 递推公式：f(1) = 0，f(i) = (f(i-1) + k) % i，时间复杂度O(n)
+func JosephusSurvivor(n, k int) int {
+	if n < 1 || k < 1 {
+		return -1
+	}
+	survivor := 0
+	for i := 2; i <= n; i++ {
+		survivor = (survivor + k) % i
+	}
+	return survivor
+}
+
+// Print 从头节点开始绕一圈打印链表
+func (l *List[T]) Print() {
+	fmt.Println("Circular List: ")
+	l.Each(func(_ int, v T) bool {
+		fmt.Print(v, " ")
+		return true
+	})
+	fmt.Println()
+}
+
+// Iterator 环形链表的迭代器，从头节点开始恰好访问一圈
+type Iterator[T any] struct {
+	cur       *Node[T]
+	next      *Node[T]
+	remaining int
+}
+
+// Next 移动到下一个元素，访问完一圈后返回false
+func (it *Iterator[T]) Next() bool {
+	if it.remaining == 0 {
+		return false
+	}
+	it.cur = it.next
+	it.next = it.cur.next
+	it.remaining--
+	return true
+}
+
+// Value 返回当前元素
+func (it *Iterator[T]) Value() T {
+	return it.cur.Value
+}
+
+// linkBefore 把不在链表中的n挂到mark之前
+func linkBefore[T any](n, mark *Node[T]) {
+	n.prev = mark.prev
+	n.next = mark
+	mark.prev.next = n
+	mark.prev = n
+}
@@ -0,0 +1,177 @@
+package circularlist
+
+import (
+	"reflect"
+	"testing"
+)
+
+// checkRing 校验链表首尾相连，并且正反两个方向的节点数都等于长度
+func checkRing[T any](t *testing.T, l *List[T]) {
+	t.Helper()
+	if l.size == 0 {
+		if l.head != nil {
+			t.Fatalf("empty list has head")
+		}
+		return
+	}
+	forward, backward := 0, 0
+	for cur := l.head; ; cur = cur.next {
+		if cur.list != l {
+			t.Fatalf("node belongs to another list")
+		}
+		forward++
+		if cur.next == l.head || forward > l.size {
+			break
+		}
+	}
+	for cur := l.head.prev; ; cur = cur.prev {
+		backward++
+		if cur == l.head || backward > l.size {
+			break
+		}
+	}
+	if forward != l.size || backward != l.size {
+		t.Fatalf("ring broken: size %d, forward %d, backward %d", l.size, forward, backward)
+	}
+}
+
+func TestList_Rotate(t *testing.T) {
+	type testCase struct {
+		name string
+		k    int
+		want []int
+	}
+	tests := []testCase{
+		{name: "zero", k: 0, want: []int{1, 2, 3, 4, 5}},
+		{name: "forward", k: 2, want: []int{3, 4, 5, 1, 2}},
+		{name: "backward", k: -1, want: []int{5, 1, 2, 3, 4}},
+		{name: "wrap", k: 7, want: []int{3, 4, 5, 1, 2}},
+		{name: "wrap_backward", k: -6, want: []int{5, 1, 2, 3, 4}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New[int](1, 2, 3, 4, 5)
+			l.Rotate(tt.k)
+			checkRing(t, l)
+			if got := l.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Rotate(%d) = %v, want %v", tt.k, got, tt.want)
+			}
+		})
+	}
+	New[int]().Rotate(3)
+}
+
+func TestList_AddRemove(t *testing.T) {
+	l := New[int](1, 2)
+	l.Add(3)
+	// Remove移出最后添加的元素，头节点不变
+	for _, want := range []int{3, 2, 1} {
+		if v, ok := l.Remove(); !ok || v != want {
+			t.Fatalf("Remove() = %d, %v, want %d", v, ok, want)
+		}
+		checkRing(t, l)
+		if l.Size() > 0 {
+			if h := l.Head(); h.Value != 1 {
+				t.Fatalf("Head() = %d after Remove", h.Value)
+			}
+		}
+	}
+	if _, ok := l.Remove(); ok || !l.IsEmpty() {
+		t.Errorf("Remove() on empty list")
+	}
+}
+
+func TestList_Step(t *testing.T) {
+	l := New[string]("a", "b", "c")
+	n := l.Head()
+	// 轮询：一直向后走会绕回头节点
+	var visited []string
+	for i := 0; i < 7; i++ {
+		visited = append(visited, n.Value)
+		n = n.Next()
+	}
+	if !reflect.DeepEqual(visited, []string{"a", "b", "c", "a", "b", "c", "a"}) {
+		t.Errorf("visited %v", visited)
+	}
+	if v := l.Step(l.Head(), -4).Value; v != "c" {
+		t.Errorf("Step(-4) = %s", v)
+	}
+	if l.Step(New[string]("x").Head(), 1) != nil {
+		t.Errorf("Step() with foreign node")
+	}
+}
+
+func TestList_Split(t *testing.T) {
+	type testCase struct {
+		name          string
+		values        []int
+		first, second []int
+	}
+	tests := []testCase{
+		{name: "empty", values: nil, first: []int{}, second: []int{}},
+		{name: "single", values: []int{1}, first: []int{1}, second: []int{}},
+		{name: "even", values: []int{1, 2, 3, 4}, first: []int{1, 2}, second: []int{3, 4}},
+		{name: "odd", values: []int{1, 2, 3, 4, 5}, first: []int{1, 2, 3}, second: []int{4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New[int](tt.values...)
+			first, second := l.Split()
+			checkRing(t, first)
+			checkRing(t, second)
+			checkRing(t, l)
+			if got := first.Values(); !reflect.DeepEqual(got, tt.first) {
+				t.Errorf("first = %v, want %v", got, tt.first)
+			}
+			if got := second.Values(); !reflect.DeepEqual(got, tt.second) {
+				t.Errorf("second = %v, want %v", got, tt.second)
+			}
+			if !l.IsEmpty() {
+				t.Errorf("original list should be empty")
+			}
+		})
+	}
+}
+
+func TestList_Josephus(t *testing.T) {
+	l := New[int](0, 1, 2, 3, 4, 5, 6)
+	order := l.Josephus(3)
+	if want := []int{2, 5, 1, 6, 4, 0, 3}; !reflect.DeepEqual(order, want) {
+		t.Errorf("Josephus(3) = %v, want %v", order, want)
+	}
+	if !l.IsEmpty() {
+		t.Errorf("list should be empty")
+	}
+
+	for n := 1; n <= 20; n++ {
+		for k := 1; k <= 5; k++ {
+			values := make([]int, n)
+			for i := range values {
+				values[i] = i
+			}
+			order := New[int](values...).Josephus(k)
+			if got := JosephusSurvivor(n, k); got != order[n-1] {
+				t.Errorf("JosephusSurvivor(%d, %d) = %d, want %d", n, k, got, order[n-1])
+			}
+		}
+	}
+}
+
+func TestList_RemoveNode(t *testing.T) {
+	l := New[int](1, 2, 3)
+	head := l.Head()
+	second := head.Next()
+	l.RemoveNode(head)
+	checkRing(t, l)
+	if l.Head() != second {
+		t.Errorf("head should move to next node")
+	}
+	l.Insert(0, 7, 8)
+	checkRing(t, l)
+	if got := l.Values(); !reflect.DeepEqual(got, []int{7, 8, 2, 3}) {
+		t.Errorf("Values() = %v", got)
+	}
+	if _, ok := l.RemoveNode(head); ok {
+		t.Errorf("RemoveNode() on removed node")
+	}
+}
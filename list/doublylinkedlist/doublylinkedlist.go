@@ -0,0 +1,350 @@
+package doublylinkedlist
+
+import (
+	"fmt"
+	dslist "github.com/dairongpeng/ds/list"
+	"github.com/dairongpeng/ds/pkg"
+)
+
+// Node 双向链表节点，可以作为句柄在O(1)时间内完成插入、删除和移动
+type Node[T any] struct {
+	Value T
+	next  *Node[T]
+	prev  *Node[T]
+	// 节点所属的链表，节点被删除后置为nil
+	list *List[T]
+}
+
+// Next 返回下一个节点，没有时返回nil
+func (n *Node[T]) Next() *Node[T] {
+	return n.next
+}
+
+// Prev 返回上一个节点，没有时返回nil
+func (n *Node[T]) Prev() *Node[T] {
+	return n.prev
+}
+
+// List 双向链表，同时维护头尾指针和长度，两端的插入删除都是O(1)
+type List[T any] struct {
+	head *Node[T]
+	tail *Node[T]
+	size int
+}
+
+// New 初始化一个双向链表，values依次追加到链表尾部
+func New[T any](values ...T) *List[T] {
+	l := &List[T]{}
+	for _, v := range values {
+		l.PushBack(v)
+	}
+	return l
+}
+
+// Head 返回头节点，链表为空时返回nil
+func (l *List[T]) Head() *Node[T] {
+	return l.head
+}
+
+// Tail 返回尾节点，链表为空时返回nil
+func (l *List[T]) Tail() *Node[T] {
+	return l.tail
+}
+
+// Add 添加一个元素到链表尾部
+func (l *List[T]) Add(v T) {
+	l.PushBack(v)
+}
+
+// Remove 从链表尾部移出一个元素，即最后添加的元素
+func (l *List[T]) Remove() (T, bool) {
+	return l.PopBack()
+}
+
+// Get 通过下标获取链表中的元素，从距离下标较近的一端开始查找
+func (l *List[T]) Get(index int) (T, bool) {
+	n := l.nodeAt(index)
+	if n == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return n.Value, true
+}
+
+// Insert 在下标index处依次插入values，index等于元素个数时追加到尾部，下标越界时返回false
+func (l *List[T]) Insert(index int, values ...T) bool {
+	if index < 0 || index > l.size {
+		return false
+	}
+	if index == l.size {
+		for _, v := range values {
+			l.PushBack(v)
+		}
+		return true
+	}
+	mark := l.nodeAt(index)
+	for _, v := range values {
+		l.InsertBefore(v, mark)
+	}
+	return true
+}
+
+// Set 修改下标为index的元素，下标越界时返回false
+func (l *List[T]) Set(index int, v T) bool {
+	n := l.nodeAt(index)
+	if n == nil {
+		return false
+	}
+	n.Value = v
+	return true
+}
+
+// PushFront 在链表头部插入一个元素，返回新节点
+func (l *List[T]) PushFront(v T) *Node[T] {
+	n := &Node[T]{Value: v, list: l}
+	if l.head == nil {
+		l.head = n
+		l.tail = n
+	} else {
+		l.linkBefore(n, l.head)
+	}
+	l.size++
+	return n
+}
+
+// PushBack 在链表尾部插入一个元素，返回新节点
+func (l *List[T]) PushBack(v T) *Node[T] {
+	n := &Node[T]{Value: v, list: l}
+	if l.tail == nil {
+		l.head = n
+		l.tail = n
+	} else {
+		l.linkAfter(n, l.tail)
+	}
+	l.size++
+	return n
+}
+
+// PopFront 移出并返回头部元素，链表为空时返回一个零值和false
+func (l *List[T]) PopFront() (T, bool) {
+	if l.head == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return l.RemoveNode(l.head)
+}
+
+// PopBack 移出并返回尾部元素，链表为空时返回一个零值和false
+func (l *List[T]) PopBack() (T, bool) {
+	if l.tail == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return l.RemoveNode(l.tail)
+}
+
+// InsertBefore 在mark节点之前插入一个元素，返回新节点。mark不属于该链表时返回nil
+func (l *List[T]) InsertBefore(v T, mark *Node[T]) *Node[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	n := &Node[T]{Value: v, list: l}
+	l.linkBefore(n, mark)
+	l.size++
+	return n
+}
+
+// InsertAfter 在mark节点之后插入一个元素，返回新节点。mark不属于该链表时返回nil
+func (l *List[T]) InsertAfter(v T, mark *Node[T]) *Node[T] {
+	if mark == nil || mark.list != l {
+		return nil
+	}
+	n := &Node[T]{Value: v, list: l}
+	l.linkAfter(n, mark)
+	l.size++
+	return n
+}
+
+// RemoveNode 删除节点n，返回节点的值。n不属于该链表时返回一个零值和false
+func (l *List[T]) RemoveNode(n *Node[T]) (T, bool) {
+	if n == nil || n.list != l {
+		var zeroValue T
+		return zeroValue, false
+	}
+	l.unlink(n)
+	n.list = nil
+	l.size--
+	return n.Value, true
+}
+
+// MoveToFront 把节点n移动到链表头部
+func (l *List[T]) MoveToFront(n *Node[T]) {
+	if n == nil || n.list != l || l.head == n {
+		return
+	}
+	l.unlink(n)
+	l.linkBefore(n, l.head)
+}
+
+// MoveToBack 把节点n移动到链表尾部
+func (l *List[T]) MoveToBack(n *Node[T]) {
+	if n == nil || n.list != l || l.tail == n {
+		return
+	}
+	l.unlink(n)
+	l.linkAfter(n, l.tail)
+}
+
+// Size 返回链表的元素个数
+func (l *List[T]) Size() int {
+	return l.size
+}
+
+// IsEmpty 判断链表是否为空
+func (l *List[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Clear 清空链表
+func (l *List[T]) Clear() {
+	// 断开已有节点与链表的关联，避免旧句柄继续操作
+	for cur := l.head; cur != nil; {
+		next := cur.next
+		cur.prev, cur.next, cur.list = nil, nil, nil
+		cur = next
+	}
+	l.head = nil
+	l.tail = nil
+	l.size = 0
+}
+
+// IndexOf 返回第一个与v相等的元素下标，不存在时返回-1
+func (l *List[T]) IndexOf(v T, cmp pkg.Comparator[T]) int {
+	index := 0
+	for cur := l.head; cur != nil; cur = cur.next {
+		if cmp(cur.Value, v) == 0 {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Contains 判断是否存在与v相等的元素
+func (l *List[T]) Contains(v T, cmp pkg.Comparator[T]) bool {
+	return l.IndexOf(v, cmp) >= 0
+}
+
+// Values 从头到尾返回所有元素
+func (l *List[T]) Values() []T {
+	values := make([]T, 0, l.size)
+	for cur := l.head; cur != nil; cur = cur.next {
+		values = append(values, cur.Value)
+	}
+	return values
+}
+
+// Each 从头到尾遍历所有元素，f返回false时提前终止遍历
+func (l *List[T]) Each(f func(index int, v T) bool) {
+	index := 0
+	for cur := l.head; cur != nil; cur = cur.next {
+		if !f(index, cur.Value) {
+			return
+		}
+		index++
+	}
+}
+
+// Iterator 返回从头到尾访问元素的迭代器
+func (l *List[T]) Iterator() dslist.Iterator[T] {
+	return &Iterator[T]{next: l.head}
+}
+
+// Print 从头到尾打印链表
+func (l *List[T]) Print() {
+	for cur := l.head; cur != nil; cur = cur.next {
+		fmt.Print(cur.Value, " ")
+	}
+	fmt.Println()
+}
+
+// nodeAt 返回下标为index的节点，越界时返回nil
+func (l *List[T]) nodeAt(index int) *Node[T] {
+	if index < 0 || index >= l.size {
+		return nil
+	}
+	// 下标在前半段从头找，在后半段从尾找
+	if index < l.size/2 {
+		cur := l.head
+		for i := 0; i < index; i++ {
+			cur = cur.next
+		}
+		return cur
+	}
+	cur := l.tail
+	for i := l.size - 1; i > index; i-- {
+		cur = cur.prev
+	}
+	return cur
+}
+
+// linkBefore 把不在链表中的n挂到mark之前
+func (l *List[T]) linkBefore(n, mark *Node[T]) {
+	n.prev = mark.prev
+	n.next = mark
+	if mark.prev == nil {
+		l.head = n
+	} else {
+		mark.prev.next = n
+	}
+	mark.prev = n
+}
+
+// linkAfter 把不在链表中的n挂到mark之后
+func (l *List[T]) linkAfter(n, mark *Node[T]) {
+	n.next = mark.next
+	n.prev = mark
+	if mark.next == nil {
+		l.tail = n
+	} else {
+		mark.next.prev = n
+	}
+	mark.next = n
+}
+
+// unlink 把n从链表中摘除，不修改长度
+func (l *List[T]) unlink(n *Node[T]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.prev = nil
+	n.next = nil
+}
+
+// Iterator 双向链表的迭代器，迭代期间可以删除当前节点
+type Iterator[T any] struct {
+	cur  *Node[T]
+	next *Node[T]
+}
+
+// Next 移动到下一个元素，没有更多元素时返回false
+func (it *Iterator[T]) Next() bool {
+	if it.next == nil {
+		return false
+	}
+	it.cur = it.next
+	it.next = it.cur.next
+	return true
+}
+
+// Value 返回当前元素
+func (it *Iterator[T]) Value() T {
+	return it.cur.Value
+}
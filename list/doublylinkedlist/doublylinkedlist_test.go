@@ -0,0 +1,94 @@
+package doublylinkedlist
+
+import (
+	dslist "github.com/dairongpeng/ds/list"
+	"reflect"
+	"testing"
+)
+
+// values 从头到尾收集元素，并反向遍历校验prev指针
+func values[T any](t *testing.T, l *List[T]) []T {
+	t.Helper()
+	result := make([]T, 0, l.Size())
+	for n := l.Head(); n != nil; n = n.Next() {
+		result = append(result, n.Value)
+	}
+	back := 0
+	for n := l.Tail(); n != nil; n = n.Prev() {
+		back++
+	}
+	if back != len(result) {
+		t.Fatalf("prev links broken: forward %d, backward %d", len(result), back)
+	}
+	return result
+}
+
+func TestList_PushPop(t *testing.T) {
+	l := New[int](2, 3)
+	l.PushFront(1)
+	l.PushBack(4)
+	if got := values(t, l); !reflect.DeepEqual(got, []int{1, 2, 3, 4}) {
+		t.Errorf("values = %v", got)
+	}
+	if v, ok := l.PopFront(); !ok || v != 1 {
+		t.Errorf("PopFront() = %d, %v", v, ok)
+	}
+	if v, ok := l.PopBack(); !ok || v != 4 {
+		t.Errorf("PopBack() = %d, %v", v, ok)
+	}
+	l.PopBack()
+	l.PopBack()
+	if _, ok := l.PopBack(); ok || !l.IsEmpty() || l.Head() != nil || l.Tail() != nil {
+		t.Errorf("list should be empty")
+	}
+}
+
+func TestList_NodeHandle(t *testing.T) {
+	l := New[string]()
+	b := l.PushBack("b")
+	l.InsertBefore("a", b)
+	d := l.InsertAfter("d", b)
+	l.InsertBefore("c", d)
+	if got := values(t, l); !reflect.DeepEqual(got, []string{"a", "b", "c", "d"}) {
+		t.Errorf("values = %v", got)
+	}
+
+	l.MoveToFront(d)
+	l.MoveToBack(b)
+	if got := values(t, l); !reflect.DeepEqual(got, []string{"d", "a", "c", "b"}) {
+		t.Errorf("values = %v", got)
+	}
+
+	if v, ok := l.RemoveNode(b); !ok || v != "b" {
+		t.Errorf("RemoveNode() = %s, %v", v, ok)
+	}
+	// 已删除的节点和其他链表的节点不能再操作
+	if _, ok := l.RemoveNode(b); ok {
+		t.Errorf("RemoveNode() twice")
+	}
+	other := New[string]("x")
+	if l.InsertAfter("y", other.Head()) != nil {
+		t.Errorf("InsertAfter() with foreign node")
+	}
+	if got := values(t, l); !reflect.DeepEqual(got, []string{"d", "a", "c"}) || l.Size() != 3 {
+		t.Errorf("values = %v", got)
+	}
+}
+
+func TestList_DSList(t *testing.T) {
+	var l dslist.DSList[int] = New[int]()
+	for i := 0; i < 5; i++ {
+		l.Add(i)
+	}
+	for i := 0; i < 5; i++ {
+		if v, ok := l.Get(i); !ok || v != i {
+			t.Errorf("Get(%d) = %d, %v", i, v, ok)
+		}
+	}
+	if _, ok := l.Get(5); ok {
+		t.Errorf("Get(5) out of range")
+	}
+	if v, ok := l.Remove(); !ok || v != 4 {
+		t.Errorf("Remove() = %d, %v", v, ok)
+	}
+}
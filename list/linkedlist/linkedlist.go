@@ -1,6 +1,10 @@
 package linkedlist
 
-import "fmt"
+import (
+	"fmt"
+	dslist "github.com/dairongpeng/ds/list"
+	"github.com/dairongpeng/ds/pkg"
+)
 
 type Node[T any] struct {
 	Value T
@@ -9,6 +13,8 @@ type Node[T any] struct {
 
 type List[T any] struct {
 	Head *Node[T]
+	// 链表长度，通过List的方法修改链表时同步维护
+	size int
 }
 
 // New 初始化一个链表结构
@@ -22,18 +28,15 @@ func New[T any](values ...T) *List[T] {
 	return l
 }
 
-// Add 添加一个元素到链表中
+// Add 添加一个元素到链表头部
 func (l *List[T]) Add(v T) {
 	newNode := &Node[T]{Value: v}
-	if l.Head == nil {
-		l.Head = newNode
-		return
-	}
 	newNode.Next = l.Head
 	l.Head = newNode
+	l.size++
 }
 
-// Remove 从链表中移出一个元素
+// Remove 从链表头部移出一个元素，即最后添加的元素
 func (l *List[T]) Remove() (T, bool) {
 	if l.Head == nil {
 		var zeroValue T
@@ -41,6 +44,7 @@ func (l *List[T]) Remove() (T, bool) {
 	}
 	v := l.Head.Value
 	l.Head = l.Head.Next
+	l.size--
 	return v, true
 }
 
@@ -68,6 +72,132 @@ func (l *List[T]) Get(index int) (T, bool) {
 	return zeroValue, false
 }
 
+// Insert 在下标index处依次插入values，index等于链表长度时插入到末尾，下标越界时返回false
+func (l *List[T]) Insert(index int, values ...T) bool {
+	if index < 0 || index > l.size {
+		return false
+	}
+	// 找到插入位置的前一个节点，在头部插入时为nil
+	var pre *Node[T]
+	if index > 0 {
+		pre = l.nodeAt(index - 1)
+	}
+	for _, v := range values {
+		newNode := &Node[T]{Value: v}
+		if pre == nil {
+			newNode.Next = l.Head
+			l.Head = newNode
+		} else {
+			newNode.Next = pre.Next
+			pre.Next = newNode
+		}
+		pre = newNode
+		l.size++
+	}
+	return true
+}
+
+// Set 修改下标为index的元素，下标越界时返回false
+func (l *List[T]) Set(index int, v T) bool {
+	node := l.nodeAt(index)
+	if node == nil {
+		return false
+	}
+	node.Value = v
+	return true
+}
+
+// Size 返回链表长度，O(1)
+func (l *List[T]) Size() int {
+	return l.size
+}
+
+// IsEmpty 判断链表是否为空
+func (l *List[T]) IsEmpty() bool {
+	return l.Head == nil
+}
+
+// Clear 清空链表
+func (l *List[T]) Clear() {
+	l.Head = nil
+	l.size = 0
+}
+
+// IndexOf 返回第一个与v相等的元素下标，不存在时返回-1
+func (l *List[T]) IndexOf(v T, cmp pkg.Comparator[T]) int {
+	index := 0
+	for cur := l.Head; cur != nil; cur = cur.Next {
+		if cmp(cur.Value, v) == 0 {
+			return index
+		}
+		index++
+	}
+	return -1
+}
+
+// Contains 判断是否存在与v相等的元素
+func (l *List[T]) Contains(v T, cmp pkg.Comparator[T]) bool {
+	return l.IndexOf(v, cmp) >= 0
+}
+
+// Values 从头到尾返回所有元素
+func (l *List[T]) Values() []T {
+	values := make([]T, 0, l.size)
+	for cur := l.Head; cur != nil; cur = cur.Next {
+		values = append(values, cur.Value)
+	}
+	return values
+}
+
+// Each 从头到尾遍历所有元素，f返回false时提前终止遍历
+func (l *List[T]) Each(f func(index int, v T) bool) {
+	index := 0
+	for cur := l.Head; cur != nil; cur = cur.Next {
+		if !f(index, cur.Value) {
+			return
+		}
+		index++
+	}
+}
+
+// Iterator 返回从头到尾访问元素的迭代器
+func (l *List[T]) Iterator() dslist.Iterator[T] {
+	return &Iterator[T]{next: l.Head}
+}
+
+// Iterator 单链表的迭代器
+type Iterator[T any] struct {
+	cur  *Node[T]
+	next *Node[T]
+}
+
+// Next 移动到下一个元素，没有更多元素时返回false
+func (it *Iterator[T]) Next() bool {
+	if it.next == nil {
+		return false
+	}
+	it.cur = it.next
+	it.next = it.cur.Next
+	return true
+}
+
+// Value 返回当前元素
+func (it *Iterator[T]) Value() T {
+	return it.cur.Value
+}
+
+// nodeAt 返回下标为index的节点，越界时返回nil
+func (l *List[T]) nodeAt(index int) *Node[T] {
+	if index < 0 || index >= l.size {
+		return nil
+	}
+	cur := l.Head
+	for i := 0; i < index; i++ {
+		cur = cur.Next
+	}
+	return cur
+}
+
 // Reverse 翻转链表
 func (l *List[T]) Reverse() {
 	if l.Head == nil || l.Head.Next == nil {
@@ -97,6 +227,7 @@ func (l *List[T]) RemoveValue(target T, cmp func(a, b any) int) {
 		}
 		// 从头节点开始，等于target的节点先滤掉
 		l.Head = l.Head.Next
+		l.size--
 	}
 
 	// 1、链表中的节点值全部都等于target
@@ -112,6 +243,7 @@ func (l *List[T]) RemoveValue(target T, cmp func(a, b any) int) {
 		// 当前节点cur往下，有多少v等于target的节点，就删除多少节点
 		if cmp(cur.Value, target) == 0 { // 当cur等于target就删除cur
 			pre.Next = cur.Next
+			l.size--
 		} else {
 			pre = cur
 		}
@@ -162,6 +294,7 @@ func (l *List[T]) RemoveLastKthNode(lastKth int) {
 	// 需要删除的是头结点
 	if lastKth == 0 {
 		l.Head = l.Head.Next
+		l.size--
 	}
 
 	if lastKth < 0 {
@@ -174,6 +307,7 @@ func (l *List[T]) RemoveLastKthNode(lastKth int) {
 
 		// 此次cur就是要删除的前一个节点。把原cur.next删除
 		cur.Next = cur.Next.Next
+		l.size--
 	}
 
 	// lastKth > 0的情况，表示倒数第lastKth节点比原链表程度要大，即不存在
@@ -191,6 +325,7 @@ func (l *List[T]) RemoveMidNode() {
 	if l.Head.Next.Next == nil {
 		// free first node mem
 		l.Head = l.Head.Next
+		l.size--
 		return
 	}
 
@@ -205,6 +340,7 @@ func (l *List[T]) RemoveMidNode() {
 
 	// 快指针走到尽头，慢指针奇数长度停留在中点，偶数长度停留在上中点。删除该节点
 	pre.Next = pre.Next.Next
+	l.size--
 
 	return
 }
@@ -255,15 +391,17 @@ func (l *List[T]) Print() {
 func PrintCommonPart[T any](l1 *List[T], l2 *List[T], cmp func(a, b any) int) {
 	fmt.Println("Common Part: ")
 
-	for l1.Head != nil && l2.Head != nil {
-		if cmp(l1.Head.Value, l2.Head.Value) < 0 { // l.Head.Value < ll.Head.Value
-			l1.Head = l1.Head.Next
-		} else if cmp(l1.Head.Value, l2.Head.Value) > 0 { // l.Head.Value > ll.Head.Value
-			l2.Head = l2.Head.Next
+	// 使用游标遍历，不修改两个链表的头节点
+	cur1, cur2 := l1.Head, l2.Head
+	for cur1 != nil && cur2 != nil {
+		if cmp(cur1.Value, cur2.Value) < 0 { // cur1.Value < cur2.Value
+			cur1 = cur1.Next
+		} else if cmp(cur1.Value, cur2.Value) > 0 { // cur1.Value > cur2.Value
+			cur2 = cur2.Next
 		} else {
-			fmt.Println(l1.Head.Value)
-			l1.Head = l1.Head.Next
-			l2.Head = l2.Head.Next
+			fmt.Println(cur1.Value)
+			cur1 = cur1.Next
+			cur2 = cur2.Next
 		}
 	}
 	fmt.Println()
@@ -318,7 +456,8 @@ func GetIntersectNode[T any](l1 *List[T], l2 *List[T]) (*Node[T], bool) {
 
 // MergeTwoList 合并两个有序链表
 func MergeTwoList[T any](l1, l2 *List[T], cmp func(a, b any) int) *List[T] {
-	var L = &List[T]{}
+	// 合并后的链表复用两个链表的节点
+	var L = &List[T]{size: l1.size + l2.size}
 
 	// base case
 	if l1.Head == nil {
@@ -0,0 +1,206 @@
+package linkedlist
+
+// Sort 自底向上的归并排序，时间复杂度O(N*logN)，额外空间复杂度O(1)
+// 只调整节点的指向，不移动节点的值，排序是稳定的
+func (l *List[T]) Sort(cmp func(a, b any) int) {
+	if l.Head == nil || l.Head.Next == nil {
+		return
+	}
+
+	dummy := &Node[T]{Next: l.Head}
+	// step为当前有序的组长度，每一轮把相邻的两组合并，直到一轮只发生一次合并
+	for step := 1; ; step <<= 1 {
+		merges := 0
+		// pre为已合并部分的尾节点
+		pre := dummy
+		cur := dummy.Next
+		for cur != nil {
+			left := cur
+			right := split(left, step)
+			cur = split(right, step)
+			pre = mergeNodes(left, right, pre, cmp)
+			merges++
+		}
+		if merges <= 1 {
+			break
+		}
+	}
+	l.Head = dummy.Next
+}
+
+// Partition 把链表按照pivot划分为小于、等于、大于三个区域，每个区域内保持节点原来的相对顺序
+func (l *List[T]) Partition(pivot T, cmp func(a, b any) int) {
+	// 三个区域各自的哑节点和尾节点
+	less, equal, more := &Node[T]{}, &Node[T]{}, &Node[T]{}
+	lessTail, equalTail, moreTail := less, equal, more
+
+	cur := l.Head
+	for cur != nil {
+		next := cur.Next
+		cur.Next = nil
+		if c := cmp(cur.Value, pivot); c < 0 {
+			lessTail.Next = cur
+			lessTail = cur
+		} else if c == 0 {
+			equalTail.Next = cur
+			equalTail = cur
+		} else {
+			moreTail.Next = cur
+			moreTail = cur
+		}
+		cur = next
+	}
+
+	// 小于区域连等于区域，等于区域为空时直接连大于区域
+	lessTail.Next = equal.Next
+	if equalTail != equal {
+		lessTail = equalTail
+	}
+	lessTail.Next = more.Next
+	l.Head = less.Next
+}
+
+// IsPalindrome 判断链表是否为回文结构，额外空间复杂度O(1)
+// 把右半部分逆序后与左半部分比较，比较完成后恢复链表
+func (l *List[T]) IsPalindrome(cmp func(a, b any) int) bool {
+	if l.Head == nil || l.Head.Next == nil {
+		return true
+	}
+
+	// 快慢指针，奇数长度slow停在中点，偶数长度slow停在上中点
+	slow, fast := l.Head, l.Head
+	for fast.Next != nil && fast.Next.Next != nil {
+		slow = slow.Next
+		fast = fast.Next.Next
+	}
+
+	right := reverseNodes(slow.Next)
+	slow.Next = nil
+
+	result := true
+	for p, q := l.Head, right; q != nil; p, q = p.Next, q.Next {
+		if cmp(p.Value, q.Value) != 0 {
+			result = false
+			break
+		}
+	}
+
+	// 恢复右半部分
+	slow.Next = reverseNodes(right)
+	return result
+}
+
+// ReverseKGroup 每k个节点一组进行翻转，最后不足k个的节点保持原有顺序
+func (l *List[T]) ReverseKGroup(k int) {
+	if k < 2 {
+		return
+	}
+
+	dummy := &Node[T]{Next: l.Head}
+	// pre为上一组翻转后的尾节点
+	pre := dummy
+	for {
+		// end来到本组的最后一个节点，不足k个时结束
+		end := pre
+		for i := 0; i < k && end != nil; i++ {
+			end = end.Next
+		}
+		if end == nil {
+			break
+		}
+
+		start := pre.Next
+		next := end.Next
+		end.Next = nil
+		// 翻转后end成为本组的头，start成为本组的尾
+		pre.Next = reverseNodes(start)
+		start.Next = next
+		pre = start
+	}
+	l.Head = dummy.Next
+}
+
+// RandomNode 带有随机指针的链表节点，Random可以指向链表中的任意节点或者为nil
+type RandomNode[T any] struct {
+	Value  T
+	Next   *RandomNode[T]
+	Random *RandomNode[T]
+}
+
+// CopyRandomList 深拷贝带有随机指针的链表，返回新链表的头节点。额外空间复杂度O(1)
+func CopyRandomList[T any](head *RandomNode[T]) *RandomNode[T] {
+	if head == nil {
+		return nil
+	}
+
+	// 1 -> 2 -> 3 调整为 1 -> 1' -> 2 -> 2' -> 3 -> 3'
+	for cur := head; cur != nil; cur = cur.Next.Next {
+		cur.Next = &RandomNode[T]{Value: cur.Value, Next: cur.Next}
+	}
+
+	// 拷贝节点的Random，就是原节点Random的下一个节点
+	for cur := head; cur != nil; cur = cur.Next.Next {
+		if cur.Random != nil {
+			cur.Next.Random = cur.Random.Next
+		}
+	}
+
+	// 分离新老链表
+	newHead := head.Next
+	for cur := head; cur != nil; cur = cur.Next {
+		copied := cur.Next
+		cur.Next = copied.Next
+		if copied.Next != nil {
+			copied.Next = copied.Next.Next
+		}
+	}
+	return newHead
+}
+
+// split 从head开始保留n个节点并断开，返回剩余部分的头节点
+func split[T any](head *Node[T], n int) *Node[T] {
+	for i := 1; head != nil && i < n; i++ {
+		head = head.Next
+	}
+	if head == nil {
+		return nil
+	}
+	rest := head.Next
+	head.Next = nil
+	return rest
+}
+
+// mergeNodes 合并两个有序链表并挂到pre之后，返回合并后的尾节点。相等时左边优先，保证稳定
+func mergeNodes[T any](left, right, pre *Node[T], cmp func(a, b any) int) *Node[T] {
+	for left != nil && right != nil {
+		if cmp(left.Value, right.Value) <= 0 {
+			pre.Next = left
+			left = left.Next
+		} else {
+			pre.Next = right
+			right = right.Next
+		}
+		pre = pre.Next
+	}
+	if left != nil {
+		pre.Next = left
+	} else {
+		pre.Next = right
+	}
+	for pre.Next != nil {
+		pre = pre.Next
+	}
+	return pre
+}
+
+// reverseNodes 翻转以head开头的链表，返回新的头节点
+func reverseNodes[T any](head *Node[T]) *Node[T] {
+	var prev *Node[T]
+	for head != nil {
+		next := head.Next
+		head.Next = prev
+		prev = head
+		head = next
+	}
+	return prev
+}
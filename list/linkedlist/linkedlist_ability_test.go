@@ -0,0 +1,196 @@
+package linkedlist
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// record 排序键相同时用seq校验稳定性
+type record struct {
+	key int
+	seq int
+}
+
+func recordCmp(a, b any) int {
+	return a.(record).key - b.(record).key
+}
+
+func intCmp(a, b any) int {
+	return a.(int) - b.(int)
+}
+
+// fromSlice 按slice的顺序构造链表，New会把元素逆序插入到头部
+func fromSlice[T any](values []T) *List[T] {
+	l := New[T]()
+	l.Insert(0, values...)
+	return l
+}
+
+func TestList_Sort(t *testing.T) {
+	for _, n := range []int{0, 1, 2, 3, 7, 64, 1000} {
+		records := make([]record, n)
+		for i := range records {
+			records[i] = record{key: rand.Intn(10), seq: i}
+		}
+		l := fromSlice(records)
+		l.Sort(recordCmp)
+
+		want := make([]record, n)
+		copy(want, records)
+		sort.SliceStable(want, func(i, j int) bool {
+			return want[i].key < want[j].key
+		})
+		if got := l.Values(); n > 0 && !reflect.DeepEqual(got, want) {
+			t.Errorf("n=%d Sort() = %v, want %v", n, got, want)
+		}
+		if l.Size() != n {
+			t.Errorf("n=%d Size() = %d", n, l.Size())
+		}
+	}
+}
+
+func TestList_Partition(t *testing.T) {
+	type testCase struct {
+		name   string
+		values []record
+		pivot  int
+		want   []record
+	}
+	tests := []testCase{
+		{
+			name:   "mixed",
+			values: []record{{5, 0}, {1, 1}, {3, 2}, {7, 3}, {3, 4}, {2, 5}, {9, 6}},
+			pivot:  3,
+			want:   []record{{1, 1}, {2, 5}, {3, 2}, {3, 4}, {5, 0}, {7, 3}, {9, 6}},
+		},
+		{
+			name:   "no_equal",
+			values: []record{{5, 0}, {1, 1}, {7, 2}, {2, 3}},
+			pivot:  4,
+			want:   []record{{1, 1}, {2, 3}, {5, 0}, {7, 2}},
+		},
+		{
+			name:   "all_less",
+			values: []record{{2, 0}, {1, 1}},
+			pivot:  4,
+			want:   []record{{2, 0}, {1, 1}},
+		},
+		{
+			name:   "all_more",
+			values: []record{{8, 0}, {6, 1}},
+			pivot:  4,
+			want:   []record{{8, 0}, {6, 1}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := fromSlice(tt.values)
+			l.Partition(record{key: tt.pivot}, recordCmp)
+			if got := l.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Partition() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+	empty := New[record]()
+	empty.Partition(record{}, recordCmp)
+	if empty.Head != nil {
+		t.Errorf("Partition() on empty list")
+	}
+}
+
+func TestList_IsPalindrome(t *testing.T) {
+	type testCase struct {
+		name   string
+		values []int
+		want   bool
+	}
+	tests := []testCase{
+		{name: "empty", values: nil, want: true},
+		{name: "single", values: []int{1}, want: true},
+		{name: "odd", values: []int{1, 2, 3, 2, 1}, want: true},
+		{name: "even", values: []int{1, 2, 2, 1}, want: true},
+		{name: "not_odd", values: []int{1, 2, 3, 1, 1}, want: false},
+		{name: "not_even", values: []int{1, 2}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := fromSlice(tt.values)
+			if got := l.IsPalindrome(intCmp); got != tt.want {
+				t.Errorf("IsPalindrome() = %v, want %v", got, tt.want)
+			}
+			// 判断之后链表结构被恢复
+			if got := l.Values(); len(tt.values) > 0 && !reflect.DeepEqual(got, tt.values) {
+				t.Errorf("list changed to %v", got)
+			}
+		})
+	}
+}
+
+func TestList_ReverseKGroup(t *testing.T) {
+	type testCase struct {
+		name string
+		k    int
+		want []int
+	}
+	tests := []testCase{
+		{name: "k1", k: 1, want: []int{1, 2, 3, 4, 5}},
+		{name: "k2", k: 2, want: []int{2, 1, 4, 3, 5}},
+		{name: "k3", k: 3, want: []int{3, 2, 1, 4, 5}},
+		{name: "k5", k: 5, want: []int{5, 4, 3, 2, 1}},
+		{name: "k6", k: 6, want: []int{1, 2, 3, 4, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := fromSlice([]int{1, 2, 3, 4, 5})
+			l.ReverseKGroup(tt.k)
+			if got := l.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ReverseKGroup(%d) = %v, want %v", tt.k, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopyRandomList(t *testing.T) {
+	if CopyRandomList[int](nil) != nil {
+		t.Errorf("CopyRandomList(nil) should be nil")
+	}
+
+	nodes := make([]*RandomNode[int], 5)
+	for i := len(nodes) - 1; i >= 0; i-- {
+		nodes[i] = &RandomNode[int]{Value: i}
+		if i+1 < len(nodes) {
+			nodes[i].Next = nodes[i+1]
+		}
+	}
+	randoms := []int{2, -1, 0, 4, 4}
+	for i, r := range randoms {
+		if r >= 0 {
+			nodes[i].Random = nodes[r]
+		}
+	}
+
+	copied := CopyRandomList(nodes[0])
+	i := 0
+	for cur, origin := copied, nodes[0]; cur != nil; cur, origin = cur.Next, origin.Next {
+		if cur == origin || cur.Value != i {
+			t.Fatalf("node %d not copied", i)
+		}
+		if randoms[i] < 0 {
+			if cur.Random != nil {
+				t.Errorf("node %d Random should be nil", i)
+			}
+		} else if cur.Random == nodes[randoms[i]] || cur.Random.Value != randoms[i] {
+			t.Errorf("node %d Random not copied", i)
+		}
+		// 原链表保持不变
+		if origin != nodes[i] {
+			t.Fatalf("original list changed at %d", i)
+		}
+		i++
+	}
+	if i != len(nodes) {
+		t.Errorf("copied %d nodes", i)
+	}
+}
@@ -1 +1,63 @@
 package linkedlist
+
+import (
+	"testing"
+)
+
+func TestList_Size(t *testing.T) {
+	cmp := func(a, b any) int {
+		return a.(int) - b.(int)
+	}
+
+	type testCase struct {
+		name   string
+		values []int
+		op     func(l *List[int])
+		want   int
+	}
+	tests := []testCase{
+		{name: "add", values: []int{1, 2, 3}, op: func(l *List[int]) { l.Add(4) }, want: 4},
+		{name: "remove", values: []int{1, 2, 3}, op: func(l *List[int]) { l.Remove() }, want: 2},
+		{name: "remove_empty", op: func(l *List[int]) { l.Remove() }, want: 0},
+		{name: "remove_value", values: []int{2, 1, 2, 2, 3, 2}, op: func(l *List[int]) { l.RemoveValue(2, cmp) }, want: 2},
+		{name: "remove_last_kth", values: []int{1, 2, 3}, op: func(l *List[int]) { l.RemoveLastKthNode(3) }, want: 2},
+		{name: "remove_last_kth_out_of_range", values: []int{1, 2, 3}, op: func(l *List[int]) { l.RemoveLastKthNode(4) }, want: 3},
+		{name: "remove_mid", values: []int{1, 2, 3, 4}, op: func(l *List[int]) { l.RemoveMidNode() }, want: 3},
+		{name: "reverse", values: []int{1, 2, 3}, op: func(l *List[int]) { l.Reverse() }, want: 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New[int](tt.values...)
+			tt.op(l)
+			if l.Size() != tt.want {
+				t.Errorf("Size() = %d, want %d", l.Size(), tt.want)
+			}
+			// 长度与实际节点数一致
+			if n := len(l.Values()); n != l.Size() {
+				t.Errorf("Size() = %d, nodes %d", l.Size(), n)
+			}
+		})
+	}
+}
+
+func TestPrintCommonPart(t *testing.T) {
+	l1 := New[int](3, 2, 1)
+	l2 := New[int](4, 2, 1)
+	cmp := func(a, b any) int {
+		return a.(int) - b.(int)
+	}
+	PrintCommonPart(l1, l2, cmp)
+	if l1.Size() != 3 || l1.Head.Value != 1 || l2.Head.Value != 1 {
+		t.Errorf("PrintCommonPart() modified the lists")
+	}
+}
+
+func TestMergeTwoList(t *testing.T) {
+	cmp := func(a, b any) int {
+		return a.(int) - b.(int)
+	}
+	l := MergeTwoList(New[int](5, 3, 1), New[int](4, 2), cmp)
+	if l.Size() != 5 || len(l.Values()) != 5 {
+		t.Errorf("Size() = %d, values %v", l.Size(), l.Values())
+	}
+}
@@ -1,8 +1,35 @@
 package dslist
 
+import "github.com/dairongpeng/ds/pkg"
+
+// DSList 线性表结构，下标从0开始
 type DSList[T any] interface {
+	// Add 在线性表的某一端添加一个元素，具体是头部还是尾部由实现决定
+	// 例如linkedlist添加到头部，arraylist添加到尾部，因此Add之后Get(0)的结果因实现而异，需要按位置操作时请使用Insert/Get
 	Add(T)
+	// Remove 从Add的同一端移出一个元素，即最后添加的元素（后进先出），线性表为空时返回一个零值和false
 	Remove() (T, bool)
 	Get(index int) (T, bool)
+	// Insert 在下标index处依次插入values，index等于元素个数时插入到末尾，下标越界时返回false
+	Insert(index int, values ...T) bool
+	// Set 修改下标为index的元素，下标越界时返回false
+	Set(index int, v T) bool
+	Size() int
+	IsEmpty() bool
+	Clear()
+	// IndexOf 返回第一个与v相等的元素下标，不存在时返回-1
+	IndexOf(v T, cmp pkg.Comparator[T]) int
+	Contains(v T, cmp pkg.Comparator[T]) bool
+	// Values 按下标顺序返回所有元素
+	Values() []T
+	// Each 按下标顺序遍历所有元素，f返回false时提前终止遍历
+	Each(f func(index int, v T) bool)
+	Iterator() Iterator[T]
 	Print()
 }
+
+// Iterator 线性表的迭代器，按下标顺序访问元素
+type Iterator[T any] interface {
+	Next() bool
+	Value() T
+}
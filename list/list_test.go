@@ -0,0 +1,100 @@
+package dslist_test
+
+import (
+	dslist "github.com/dairongpeng/ds/list"
+	"github.com/dairongpeng/ds/list/arraylist"
+	"github.com/dairongpeng/ds/list/circularlist"
+	"github.com/dairongpeng/ds/list/doublylinkedlist"
+	"github.com/dairongpeng/ds/list/linkedlist"
+	"github.com/dairongpeng/ds/list/unrolled"
+	"github.com/dairongpeng/ds/pkg"
+	"reflect"
+	"testing"
+)
+
+// 所有DSList实现都要满足的行为，Add和Remove操作的位置由各实现决定，这里只通过下标操作校验
+func TestDSList(t *testing.T) {
+	type testCase struct {
+		name    string
+		newList func() dslist.DSList[int]
+	}
+	tests := []testCase{
+		{name: "linkedlist", newList: func() dslist.DSList[int] { return linkedlist.New[int]() }},
+		{name: "doublylinkedlist", newList: func() dslist.DSList[int] { return doublylinkedlist.New[int]() }},
+		{name: "arraylist", newList: func() dslist.DSList[int] { return arraylist.New[int]() }},
+		{name: "circularlist", newList: func() dslist.DSList[int] { return circularlist.New[int]() }},
+		{name: "unrolled", newList: func() dslist.DSList[int] { return unrolled.New[int]() }},
+	}
+	cmp := pkg.NumberComparator[int]
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := tt.newList()
+			if !l.IsEmpty() || l.Size() != 0 {
+				t.Fatalf("new list should be empty")
+			}
+			if l.Insert(1, 0) {
+				t.Errorf("Insert(1) on empty list")
+			}
+
+			l.Insert(0, 1, 4)
+			l.Insert(1, 2, 3)
+			l.Insert(4, 5)
+			if got := l.Values(); !reflect.DeepEqual(got, []int{1, 2, 3, 4, 5}) {
+				t.Errorf("Values() = %v", got)
+			}
+			if l.Size() != 5 {
+				t.Errorf("Size() = %d", l.Size())
+			}
+
+			if !l.Set(4, 50) || l.Set(5, 0) || l.Set(-1, 0) {
+				t.Errorf("Set() mismatch")
+			}
+			if v, ok := l.Get(4); !ok || v != 50 {
+				t.Errorf("Get(4) = %d, %v", v, ok)
+			}
+			if i := l.IndexOf(3, cmp); i != 2 {
+				t.Errorf("IndexOf(3) = %d", i)
+			}
+			if l.Contains(5, cmp) || !l.Contains(50, cmp) {
+				t.Errorf("Contains() mismatch")
+			}
+
+			var visited []int
+			l.Each(func(index int, v int) bool {
+				if v != l.Values()[index] {
+					t.Errorf("Each() index %d = %d", index, v)
+				}
+				visited = append(visited, v)
+				return index < 2
+			})
+			if !reflect.DeepEqual(visited, []int{1, 2, 3}) {
+				t.Errorf("Each() visited %v", visited)
+			}
+
+			visited = nil
+			for it := l.Iterator(); it.Next(); {
+				visited = append(visited, it.Value())
+			}
+			if !reflect.DeepEqual(visited, []int{1, 2, 3, 4, 50}) {
+				t.Errorf("Iterator() visited %v", visited)
+			}
+
+			// Add添加到哪一端由实现决定，Remove总是移出最后添加的元素，其余元素的顺序不变
+			l.Add(6)
+			if v, ok := l.Remove(); !ok || v != 6 {
+				t.Errorf("Remove() = %d, %v, want 6", v, ok)
+			}
+			if got := l.Values(); l.Size() != 5 || !reflect.DeepEqual(got, []int{1, 2, 3, 4, 50}) {
+				t.Errorf("Values() after Add/Remove = %v, Size() = %d", got, l.Size())
+			}
+
+			l.Clear()
+			if !l.IsEmpty() || l.Size() != 0 || len(l.Values()) != 0 {
+				t.Errorf("Clear() left elements")
+			}
+			if it := l.Iterator(); it.Next() {
+				t.Errorf("Iterator() on empty list")
+			}
+		})
+	}
+}
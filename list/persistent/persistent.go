@@ -0,0 +1,160 @@
+package persistent
+
+import "fmt"
+
+// List 不可变（持久化）单链表，nil表示空链表
+// 每个节点本身就是以它开头的链表，创建后不再修改。Cons/Tail/Prepend返回的新链表与原链表共享节点，
+// 因此可以在多个goroutine之间直接传递和读取，不需要加锁或深拷贝
+type List[T any] struct {
+	value T
+	next  *List[T]
+	// 以该节点开头的链表长度
+	size int
+}
+
+// New 用values构造一个链表，values[0]为头部元素。没有元素时返回nil，即空链表
+func New[T any](values ...T) *List[T] {
+	var l *List[T]
+	for i := len(values) - 1; i >= 0; i-- {
+		l = l.Cons(values[i])
+	}
+	return l
+}
+
+// Cons 返回在头部添加v后的新链表，原链表不变，O(1)
+func (l *List[T]) Cons(v T) *List[T] {
+	return &List[T]{value: v, next: l, size: l.Size() + 1}
+}
+
+// Prepend 返回在头部依次添加values后的新链表，values[0]成为新的头部元素，原链表不变
+func (l *List[T]) Prepend(values ...T) *List[T] {
+	for i := len(values) - 1; i >= 0; i-- {
+		l = l.Cons(values[i])
+	}
+	return l
+}
+
+// Head 返回头部元素，链表为空时返回一个零值和false
+func (l *List[T]) Head() (T, bool) {
+	if l == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return l.value, true
+}
+
+// Tail 返回去掉头部元素后的链表，与原链表共享所有节点，O(1)。链表为空时返回nil和false
+func (l *List[T]) Tail() (*List[T], bool) {
+	if l == nil {
+		return nil, false
+	}
+	return l.next, true
+}
+
+// Get 通过下标获取元素
+func (l *List[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= l.Size() {
+		var zeroValue T
+		return zeroValue, false
+	}
+	cur := l
+	for ; index > 0; index-- {
+		cur = cur.next
+	}
+	return cur.value, true
+}
+
+// Size 返回链表长度，O(1)
+func (l *List[T]) Size() int {
+	if l == nil {
+		return 0
+	}
+	return l.size
+}
+
+// IsEmpty 判断链表是否为空
+func (l *List[T]) IsEmpty() bool {
+	return l == nil
+}
+
+// Values 从头到尾返回所有元素
+func (l *List[T]) Values() []T {
+	values := make([]T, 0, l.Size())
+	for cur := l; cur != nil; cur = cur.next {
+		values = append(values, cur.value)
+	}
+	return values
+}
+
+// Each 从头到尾遍历所有元素，f返回false时提前终止遍历
+func (l *List[T]) Each(f func(index int, v T) bool) {
+	index := 0
+	for cur := l; cur != nil; cur = cur.next {
+		if !f(index, cur.value) {
+			return
+		}
+		index++
+	}
+}
+
+// Reverse 返回逆序的新链表
+func (l *List[T]) Reverse() *List[T] {
+	var reversed *List[T]
+	for cur := l; cur != nil; cur = cur.next {
+		reversed = reversed.Cons(cur.value)
+	}
+	return reversed
+}
+
+// Filter 返回只保留满足pred的元素的新链表
+// 最后一个被过滤掉的元素之后的部分全部保留，新链表直接共享这一段节点
+func (l *List[T]) Filter(pred func(v T) bool) *List[T] {
+	var kept []*List[T]
+	var lastRejected *List[T]
+	// lastRejected之前保留下来的节点个数
+	keptBefore := 0
+	for cur := l; cur != nil; cur = cur.next {
+		if pred(cur.value) {
+			kept = append(kept, cur)
+		} else {
+			lastRejected = cur
+			keptBefore = len(kept)
+		}
+	}
+	if lastRejected == nil {
+		return l
+	}
+
+	result := lastRejected.next
+	for i := keptBefore - 1; i >= 0; i-- {
+		result = result.Cons(kept[i].value)
+	}
+	return result
+}
+
+// Print 从头到尾打印链表
+func (l *List[T]) Print() {
+	fmt.Println("Persistent List: ")
+	for cur := l; cur != nil; cur = cur.next {
+		fmt.Print(cur.value, " ")
+	}
+	fmt.Println()
+}
+
+// Map 对每个元素应用f，返回由结果组成的新链表
+func Map[T any, U any](l *List[T], f func(v T) U) *List[U] {
+	values := make([]U, 0, l.Size())
+	for cur := l; cur != nil; cur = cur.next {
+		values = append(values, f(cur.value))
+	}
+	return New(values...)
+}
+
+// Fold 从头到尾累积计算，acc的初始值为init，依次计算 acc = f(acc, v)
+func Fold[T any, A any](l *List[T], init A, f func(acc A, v T) A) A {
+	acc := init
+	for cur := l; cur != nil; cur = cur.next {
+		acc = f(acc, cur.value)
+	}
+	return acc
+}
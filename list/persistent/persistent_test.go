@@ -0,0 +1,136 @@
+package persistent
+
+import (
+	"reflect"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestList_Sharing(t *testing.T) {
+	base := New[int](2, 3)
+	a := base.Cons(1)
+	b := base.Prepend(8, 9)
+
+	if got := a.Values(); !reflect.DeepEqual(got, []int{1, 2, 3}) {
+		t.Errorf("a = %v", got)
+	}
+	if got := b.Values(); !reflect.DeepEqual(got, []int{8, 9, 2, 3}) {
+		t.Errorf("b = %v", got)
+	}
+	if got := base.Values(); !reflect.DeepEqual(got, []int{2, 3}) {
+		t.Errorf("base changed to %v", got)
+	}
+
+	// 共享同一段节点
+	aTail, _ := a.Tail()
+	bTail, _ := b.Tail()
+	bTail, _ = bTail.Tail()
+	if aTail != base || bTail != base {
+		t.Errorf("Tail() should share nodes with base")
+	}
+	if a.Size() != 3 || b.Size() != 4 || base.Size() != 2 {
+		t.Errorf("Size() mismatch")
+	}
+	if v, ok := b.Get(3); !ok || v != 3 {
+		t.Errorf("Get(3) = %d, %v", v, ok)
+	}
+}
+
+func TestList_Empty(t *testing.T) {
+	var l *List[int]
+	if !l.IsEmpty() || l.Size() != 0 || New[int]() != nil {
+		t.Errorf("empty list mismatch")
+	}
+	if _, ok := l.Head(); ok {
+		t.Errorf("Head() on empty list")
+	}
+	if _, ok := l.Tail(); ok {
+		t.Errorf("Tail() on empty list")
+	}
+	if _, ok := l.Get(0); ok {
+		t.Errorf("Get(0) on empty list")
+	}
+	if len(l.Values()) != 0 || l.Reverse() != nil || l.Filter(func(int) bool { return true }) != nil {
+		t.Errorf("operations on empty list")
+	}
+	if v, ok := l.Cons(1).Head(); !ok || v != 1 {
+		t.Errorf("Cons() on empty list")
+	}
+}
+
+func TestList_Filter(t *testing.T) {
+	type testCase struct {
+		name   string
+		values []int
+		want   []int
+		// 结果与原链表共享的节点数
+		shared int
+	}
+	even := func(v int) bool { return v%2 == 0 }
+	tests := []testCase{
+		{name: "all_kept", values: []int{2, 4, 6}, want: []int{2, 4, 6}, shared: 3},
+		{name: "none_kept", values: []int{1, 3}, want: []int{}, shared: 0},
+		{name: "shared_suffix", values: []int{2, 1, 4, 6}, want: []int{2, 4, 6}, shared: 2},
+		{name: "last_rejected", values: []int{2, 4, 5}, want: []int{2, 4}, shared: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l := New[int](tt.values...)
+			got := l.Filter(even)
+			if values := got.Values(); !reflect.DeepEqual(values, tt.want) {
+				t.Errorf("Filter() = %v, want %v", values, tt.want)
+			}
+			// 原链表的最后shared个节点应该出现在结果中
+			suffix := l
+			for i := 0; i < l.Size()-tt.shared; i++ {
+				suffix, _ = suffix.Tail()
+			}
+			found := suffix == nil
+			for cur := got; cur != nil && !found; cur, _ = cur.Tail() {
+				found = cur == suffix
+			}
+			if !found {
+				t.Errorf("Filter() does not share the last %d nodes", tt.shared)
+			}
+			if !reflect.DeepEqual(l.Values(), tt.values) {
+				t.Errorf("original list changed")
+			}
+		})
+	}
+}
+
+func TestMapFold(t *testing.T) {
+	l := New[int](1, 2, 3)
+	strs := Map(l, func(v int) string { return strconv.Itoa(v * 10) })
+	if got := strs.Values(); !reflect.DeepEqual(got, []string{"10", "20", "30"}) {
+		t.Errorf("Map() = %v", got)
+	}
+	sum := Fold(l, 0, func(acc int, v int) int { return acc + v })
+	if sum != 6 {
+		t.Errorf("Fold() = %d", sum)
+	}
+	joined := Fold(strs, "", func(acc string, v string) string { return acc + v })
+	if joined != "102030" {
+		t.Errorf("Fold() = %s", joined)
+	}
+	if got := l.Reverse().Values(); !reflect.DeepEqual(got, []int{3, 2, 1}) {
+		t.Errorf("Reverse() = %v", got)
+	}
+}
+
+func TestList_Concurrent(t *testing.T) {
+	base := New[int](1, 2, 3)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			l := base.Cons(i)
+			if l.Size() != 4 || Fold(l, 0, func(acc, v int) int { return acc + v }) != 6+i {
+				t.Errorf("goroutine %d saw inconsistent list", i)
+			}
+		}(i)
+	}
+	wg.Wait()
+}
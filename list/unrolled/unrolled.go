@@ -0,0 +1,336 @@
+package unrolled
+
+import (
+	"fmt"
+	dslist "github.com/dairongpeng/ds/list"
+	"github.com/dairongpeng/ds/pkg"
+)
+
+// 最小的块容量
+const minBlockSize = 16
+
+// node 链表节点，一个节点保存一块连续的元素，元素个数不超过块容量
+type node[T any] struct {
+	elements []T
+	prev     *node[T]
+	next     *node[T]
+}
+
+// List 展开链表，每个节点保存一个小数组，节点数少、内存连续，遍历时对缓存友好，GC压力也远小于每个元素一个节点的链表
+// 块容量随元素个数自动调整为约sqrt(n)，因此节点数也约为sqrt(n)，Get/Set/Insert/RemoveAt都是O(sqrt(n))
+type List[T any] struct {
+	head *node[T]
+	tail *node[T]
+	size int
+	// 当前的块容量
+	blockSize int
+}
+
+// New 初始化一个展开链表，values依次追加到尾部
+func New[T any](values ...T) *List[T] {
+	l := &List[T]{blockSize: minBlockSize}
+	for _, v := range values {
+		l.Add(v)
+	}
+	return l
+}
+
+// Add 添加一个元素到链表尾部，O(1)
+func (l *List[T]) Add(v T) {
+	if l.tail == nil || len(l.tail.elements) >= l.blockSize {
+		l.linkAfter(l.newNode(), l.tail)
+	}
+	l.tail.elements = append(l.tail.elements, v)
+	l.size++
+	l.rebalance()
+}
+
+// Remove 从链表尾部移出一个元素，即最后添加的元素
+func (l *List[T]) Remove() (T, bool) {
+	return l.RemoveAt(l.size - 1)
+}
+
+// Get 通过下标获取元素
+func (l *List[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= l.size {
+		var zeroValue T
+		return zeroValue, false
+	}
+	n, offset := l.locate(index)
+	return n.elements[offset], true
+}
+
+// Set 修改下标为index的元素，下标越界时返回false
+func (l *List[T]) Set(index int, v T) bool {
+	if index < 0 || index >= l.size {
+		return false
+	}
+	n, offset := l.locate(index)
+	n.elements[offset] = v
+	return true
+}
+
+// Insert 在下标index处依次插入values，index等于元素个数时追加到尾部，下标越界时返回false
+func (l *List[T]) Insert(index int, values ...T) bool {
+	if index < 0 || index > l.size {
+		return false
+	}
+	for i, v := range values {
+		l.insertAt(index+i, v)
+	}
+	return true
+}
+
+// RemoveAt 删除并返回下标为index的元素，下标越界时返回一个零值和false
+func (l *List[T]) RemoveAt(index int) (T, bool) {
+	var zeroValue T
+	if index < 0 || index >= l.size {
+		return zeroValue, false
+	}
+	n, offset := l.locate(index)
+	v := n.elements[offset]
+	last := len(n.elements) - 1
+	copy(n.elements[offset:], n.elements[offset+1:])
+	// 清除引用，避免内存泄漏
+	n.elements[last] = zeroValue
+	n.elements = n.elements[:last]
+	l.size--
+
+	if len(n.elements) == 0 {
+		l.unlink(n)
+	} else if len(n.elements) < l.blockSize/2 {
+		// 节点过空时与相邻节点合并，保证节点数不会过多
+		if n.next != nil && len(n.elements)+len(n.next.elements) <= l.blockSize {
+			l.merge(n, n.next)
+		} else if n.prev != nil && len(n.prev.elements)+len(n.elements) <= l.blockSize {
+			l.merge(n.prev, n)
+		}
+	}
+	l.rebalance()
+	return v, true
+}
+
+// Size 返回元素个数
+func (l *List[T]) Size() int {
+	return l.size
+}
+
+// IsEmpty 判断链表是否为空
+func (l *List[T]) IsEmpty() bool {
+	return l.size == 0
+}
+
+// Clear 清空链表
+func (l *List[T]) Clear() {
+	l.head = nil
+	l.tail = nil
+	l.size = 0
+	l.blockSize = minBlockSize
+}
+
+// IndexOf 返回第一个与v相等的元素下标，不存在时返回-1
+func (l *List[T]) IndexOf(v T, cmp pkg.Comparator[T]) int {
+	index := 0
+	for n := l.head; n != nil; n = n.next {
+		for _, e := range n.elements {
+			if cmp(e, v) == 0 {
+				return index
+			}
+			index++
+		}
+	}
+	return -1
+}
+
+// Contains 判断是否存在与v相等的元素
+func (l *List[T]) Contains(v T, cmp pkg.Comparator[T]) bool {
+	return l.IndexOf(v, cmp) >= 0
+}
+
+// Values 按下标顺序返回所有元素
+func (l *List[T]) Values() []T {
+	values := make([]T, 0, l.size)
+	for n := l.head; n != nil; n = n.next {
+		values = append(values, n.elements...)
+	}
+	return values
+}
+
+// Each 按下标顺序遍历所有元素，f返回false时提前终止遍历
+func (l *List[T]) Each(f func(index int, v T) bool) {
+	index := 0
+	for n := l.head; n != nil; n = n.next {
+		for _, e := range n.elements {
+			if !f(index, e) {
+				return
+			}
+			index++
+		}
+	}
+}
+
+// Iterator 返回按下标顺序访问元素的迭代器
+func (l *List[T]) Iterator() dslist.Iterator[T] {
+	return &Iterator[T]{node: l.head, offset: -1}
+}
+
+// Print 打印链表
+func (l *List[T]) Print() {
+	fmt.Println("Unrolled List: ")
+	for n := l.head; n != nil; n = n.next {
+		fmt.Print(n.elements, " ")
+	}
+	fmt.Println()
+}
+
+// Iterator 展开链表的迭代器，迭代期间不能插入或删除元素
+type Iterator[T any] struct {
+	node   *node[T]
+	offset int
+}
+
+// Next 移动到下一个元素，没有更多元素时返回false
+func (it *Iterator[T]) Next() bool {
+	it.offset++
+	for it.node != nil && it.offset >= len(it.node.elements) {
+		it.node = it.node.next
+		it.offset = 0
+	}
+	return it.node != nil
+}
+
+// Value 返回当前元素
+func (it *Iterator[T]) Value() T {
+	return it.node.elements[it.offset]
+}
+
+// insertAt 在下标index处插入v，节点已满时先把节点对半拆分
+func (l *List[T]) insertAt(index int, v T) {
+	if index == l.size {
+		l.Add(v)
+		return
+	}
+	n, offset := l.locate(index)
+	if len(n.elements) >= l.blockSize {
+		half := len(n.elements) / 2
+		right := l.newNode()
+		right.elements = append(right.elements, n.elements[half:]...)
+		clearTail(n.elements, half)
+		n.elements = n.elements[:half]
+		l.linkAfter(right, n)
+		if offset >= half {
+			n = right
+			offset -= half
+		}
+	}
+	var zeroValue T
+	n.elements = append(n.elements, zeroValue)
+	copy(n.elements[offset+1:], n.elements[offset:])
+	n.elements[offset] = v
+	l.size++
+	l.rebalance()
+}
+
+// locate 返回下标index所在的节点和节点内的偏移，从距离较近的一端开始查找
+func (l *List[T]) locate(index int) (*node[T], int) {
+	if index < l.size/2 {
+		n := l.head
+		for index >= len(n.elements) {
+			index -= len(n.elements)
+			n = n.next
+		}
+		return n, index
+	}
+	// 从尾部查找，index转换为距离尾部的位置
+	index = l.size - 1 - index
+	n := l.tail
+	for index >= len(n.elements) {
+		index -= len(n.elements)
+		n = n.prev
+	}
+	return n, len(n.elements) - 1 - index
+}
+
+// rebalance 元素个数偏离块容量的平方过多时，调整块容量并重建所有节点
+// 每次重建后至少经过O(n)次操作才会再次重建，摊还代价为O(1)
+func (l *List[T]) rebalance() {
+	b := l.blockSize
+	if l.size > 4*b*b {
+		l.rebuild(2 * b)
+	} else if b > minBlockSize && l.size < b*b/4 {
+		l.rebuild(b / 2)
+	}
+}
+
+// rebuild 按照新的块容量重新分块，每个节点填满
+func (l *List[T]) rebuild(blockSize int) {
+	values := l.Values()
+	l.head = nil
+	l.tail = nil
+	l.blockSize = blockSize
+	for i := 0; i < len(values); i += blockSize {
+		end := i + blockSize
+		if end > len(values) {
+			end = len(values)
+		}
+		n := l.newNode()
+		n.elements = append(n.elements, values[i:end]...)
+		l.linkAfter(n, l.tail)
+	}
+}
+
+// merge 把right的元素移动到left末尾，并删除right
+func (l *List[T]) merge(left, right *node[T]) {
+	left.elements = append(left.elements, right.elements...)
+	l.unlink(right)
+}
+
+func (l *List[T]) newNode() *node[T] {
+	return &node[T]{elements: make([]T, 0, l.blockSize)}
+}
+
+// linkAfter 把n挂到mark之后，mark为nil时挂到链表头部
+func (l *List[T]) linkAfter(n, mark *node[T]) {
+	if mark == nil {
+		n.next = l.head
+		if l.head != nil {
+			l.head.prev = n
+		} else {
+			l.tail = n
+		}
+		l.head = n
+		return
+	}
+	n.prev = mark
+	n.next = mark.next
+	if mark.next != nil {
+		mark.next.prev = n
+	} else {
+		l.tail = n
+	}
+	mark.next = n
+}
+
+// unlink 把n从链表中摘除
+func (l *List[T]) unlink(n *node[T]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		l.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		l.tail = n.prev
+	}
+	n.prev = nil
+	n.next = nil
+}
+
+// clearTail 清除s[from:]的引用
+func clearTail[T any](s []T, from int) {
+	var zeroValue T
+	for i := from; i < len(s); i++ {
+		s[i] = zeroValue
+	}
+}
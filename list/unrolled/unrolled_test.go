@@ -0,0 +1,105 @@
+package unrolled
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// checkInvariants 校验节点链接、节点大小和元素总数
+func checkInvariants[T any](t *testing.T, l *List[T]) {
+	t.Helper()
+	count, nodes := 0, 0
+	var prev *node[T]
+	for n := l.head; n != nil; n = n.next {
+		if n.prev != prev {
+			t.Fatalf("node %d prev link broken", nodes)
+		}
+		if len(n.elements) == 0 || len(n.elements) > l.blockSize {
+			t.Fatalf("node %d has %d elements, block size %d", nodes, len(n.elements), l.blockSize)
+		}
+		count += len(n.elements)
+		nodes++
+		prev = n
+	}
+	if l.tail != prev {
+		t.Fatalf("tail mismatch")
+	}
+	if count != l.size {
+		t.Fatalf("size %d, counted %d", l.size, count)
+	}
+	// 相邻节点合并后不小于半块，节点数不超过 2n/b + 1
+	if nodes > 2*l.size/l.blockSize+1 {
+		t.Fatalf("%d nodes for %d elements, block size %d", nodes, l.size, l.blockSize)
+	}
+}
+
+func TestList_Random(t *testing.T) {
+	l := New[int]()
+	var model []int
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		switch op := r.Intn(10); {
+		case op < 5:
+			index := r.Intn(len(model) + 1)
+			l.Insert(index, i)
+			model = append(model, 0)
+			copy(model[index+1:], model[index:])
+			model[index] = i
+		case op < 8 && len(model) > 0:
+			index := r.Intn(len(model))
+			v, ok := l.RemoveAt(index)
+			if !ok || v != model[index] {
+				t.Fatalf("RemoveAt(%d) = %d, %v, want %d", index, v, ok, model[index])
+			}
+			model = append(model[:index], model[index+1:]...)
+		case len(model) > 0:
+			index := r.Intn(len(model))
+			if v, ok := l.Get(index); !ok || v != model[index] {
+				t.Fatalf("Get(%d) = %d, %v, want %d", index, v, ok, model[index])
+			}
+			l.Set(index, -i)
+			model[index] = -i
+		}
+		if i%1000 == 0 {
+			checkInvariants(t, l)
+		}
+	}
+	checkInvariants(t, l)
+	if got := l.Values(); !reflect.DeepEqual(got, model) {
+		t.Fatalf("Values() mismatch")
+	}
+}
+
+func TestList_BlockSize(t *testing.T) {
+	l := New[int]()
+	for i := 0; i < 100000; i++ {
+		l.Add(i)
+	}
+	checkInvariants(t, l)
+	// 块容量约为sqrt(n)
+	if l.blockSize*l.blockSize > 4*l.size || 4*l.blockSize*l.blockSize < l.size {
+		t.Errorf("block size %d for %d elements", l.blockSize, l.size)
+	}
+
+	i := 0
+	for it := l.Iterator(); it.Next(); i++ {
+		if it.Value() != i {
+			t.Fatalf("Iterator() = %d at %d", it.Value(), i)
+		}
+	}
+	if i != l.size {
+		t.Errorf("Iterator() visited %d", i)
+	}
+
+	for l.Size() > 10 {
+		l.Remove()
+	}
+	checkInvariants(t, l)
+	if l.blockSize != minBlockSize {
+		t.Errorf("block size %d after shrinking", l.blockSize)
+	}
+	if got := l.Values(); !reflect.DeepEqual(got, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}) {
+		t.Errorf("Values() = %v", got)
+	}
+}
@@ -0,0 +1,251 @@
+package hashmap
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/pkg"
+)
+
+const (
+	// 默认初始容量，容量始终保持为2的幂，便于用位运算取模
+	defaultCapacity = 16
+	// 默认负载因子，元素个数超过容量*负载因子时扩容
+	defaultLoadFactor = 0.75
+)
+
+// entry 哈希表中的一个槽位
+type entry[K any, V any] struct {
+	key   K
+	value V
+	// 打散后的哈希值，扩容和比较时无需重复计算
+	hash uint64
+	// 探测距离，即当前位置距离理想位置有多远
+	dist int
+	// 槽位是否被占用
+	used bool
+}
+
+// HashMap 基于开放寻址法（Robin Hood Hashing）实现的哈希表
+// key不要求是comparable的，由用户提供哈希函数和比较器，因此可以用包含切片的结构体作为key。
+//  1. 插入时线性探测，如果当前槽位元素的探测距离比待插入元素小（"富人"），则交换两者，继续为被换出的元素寻找位置（"劫富济贫"）
+//  2. 查找时如果遇到空槽位，或者槽位元素的探测距离比当前探测距离小，即可提前确定key不存在
+//  3. 删除时不使用墓碑，而是把后续探测距离大于0的元素依次前移一位（backward shift）
+type HashMap[K any, V any] struct {
+	slots []entry[K, V]
+	size  int
+	// 容量减一，用于位运算取模
+	mask uint64
+	// 负载因子
+	loadFactor float64
+	hasher     pkg.Hasher[K]
+	cmp        pkg.Comparator[K]
+}
+
+// New 初始化一个哈希表，hasher和comparator需要满足：比较相等的key，哈希值也必须相等
+func New[K any, V any](hasher pkg.Hasher[K], comparator pkg.Comparator[K]) *HashMap[K, V] {
+	return NewWithLoadFactor[K, V](defaultCapacity, defaultLoadFactor, hasher, comparator)
+}
+
+// NewWithLoadFactor 指定初始容量和负载因子初始化一个哈希表
+// 容量会向上取整到2的幂，负载因子不在(0, 1)范围内时使用默认值0.75
+func NewWithLoadFactor[K any, V any](capacity int, loadFactor float64, hasher pkg.Hasher[K], comparator pkg.Comparator[K]) *HashMap[K, V] {
+	if loadFactor <= 0 || loadFactor >= 1 {
+		loadFactor = defaultLoadFactor
+	}
+	c := roundUpPowerOfTwo(capacity)
+	return &HashMap[K, V]{
+		slots:      make([]entry[K, V], c),
+		mask:       uint64(c - 1),
+		loadFactor: loadFactor,
+		hasher:     hasher,
+		cmp:        comparator,
+	}
+}
+
+// Put 添加一个键值对，如果key已经存在则覆盖原来的value
+func (m *HashMap[K, V]) Put(key K, value V) {
+	h := mix(m.hasher(key))
+	if i, ok := m.find(key, h); ok {
+		m.slots[i].value = value
+		return
+	}
+
+	// 新元素加入后超过负载因子，先扩容
+	if float64(m.size+1) > float64(len(m.slots))*m.loadFactor {
+		m.resize(len(m.slots) * 2)
+	}
+	m.insert(entry[K, V]{key: key, value: value, hash: h, used: true})
+}
+
+// Get 通过key获取value，如果key不存在则返回一个零值和false
+func (m *HashMap[K, V]) Get(key K) (V, bool) {
+	if i, ok := m.find(key, mix(m.hasher(key))); ok {
+		return m.slots[i].value, true
+	}
+	var zeroValue V
+	return zeroValue, false
+}
+
+// Remove 删除key对应的键值对，返回被删除的value。如果key不存在则返回一个零值和false
+func (m *HashMap[K, V]) Remove(key K) (V, bool) {
+	i, ok := m.find(key, mix(m.hasher(key)))
+	if !ok {
+		var zeroValue V
+		return zeroValue, false
+	}
+	v := m.slots[i].value
+
+	// backward shift：后面探测距离大于0的元素依次前移，填补空出的槽位
+	j := (i + 1) & m.mask
+	for m.slots[j].used && m.slots[j].dist > 0 {
+		m.slots[i] = m.slots[j]
+		m.slots[i].dist--
+		i = j
+		j = (j + 1) & m.mask
+	}
+	m.slots[i] = entry[K, V]{}
+	m.size--
+
+	// 元素过于稀疏时缩容，释放内存
+	if len(m.slots) > defaultCapacity && m.size < len(m.slots)/8 {
+		m.resize(len(m.slots) / 2)
+	}
+	return v, true
+}
+
+// Contains 判断key是否存在
+func (m *HashMap[K, V]) Contains(key K) bool {
+	_, ok := m.find(key, mix(m.hasher(key)))
+	return ok
+}
+
+// Size 返回键值对的个数
+func (m *HashMap[K, V]) Size() int {
+	return m.size
+}
+
+// IsEmpty 判断哈希表是否为空
+func (m *HashMap[K, V]) IsEmpty() bool {
+	return m.size == 0
+}
+
+// Capacity 返回当前槽位的个数
+func (m *HashMap[K, V]) Capacity() int {
+	return len(m.slots)
+}
+
+// Clear 清空哈希表，容量恢复为默认值
+func (m *HashMap[K, V]) Clear() {
+	m.slots = make([]entry[K, V], defaultCapacity)
+	m.mask = defaultCapacity - 1
+	m.size = 0
+}
+
+// Keys 返回所有的key，顺序不做保证
+func (m *HashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.size)
+	for i := range m.slots {
+		if m.slots[i].used {
+			keys = append(keys, m.slots[i].key)
+		}
+	}
+	return keys
+}
+
+// Values 返回所有的value，顺序不做保证
+func (m *HashMap[K, V]) Values() []V {
+	values := make([]V, 0, m.size)
+	for i := range m.slots {
+		if m.slots[i].used {
+			values = append(values, m.slots[i].value)
+		}
+	}
+	return values
+}
+
+// Each 遍历所有键值对，顺序不做保证，f返回false时提前终止遍历
+func (m *HashMap[K, V]) Each(f func(key K, value V) bool) {
+	for i := range m.slots {
+		if m.slots[i].used && !f(m.slots[i].key, m.slots[i].value) {
+			return
+		}
+	}
+}
+
+// Print 打印哈希表
+func (m *HashMap[K, V]) Print() {
+	fmt.Println("Hash Map: ")
+	for i := range m.slots {
+		if m.slots[i].used {
+			fmt.Print(m.slots[i].key, ":", m.slots[i].value, " ")
+		}
+	}
+	fmt.Println()
+}
+
+// find 查找key所在的槽位
+func (m *HashMap[K, V]) find(key K, h uint64) (uint64, bool) {
+	i := h & m.mask
+	for d := 0; ; d++ {
+		s := &m.slots[i]
+		// 遇到空槽位，或者遇到比自己更"富"的元素，说明key不可能在更后面
+		if !s.used || s.dist < d {
+			return 0, false
+		}
+		if s.hash == h && m.cmp(s.key, key) == 0 {
+			return i, true
+		}
+		i = (i + 1) & m.mask
+	}
+}
+
+// insert 插入一个确定不存在的元素，调用方需要保证有空余槽位
+func (m *HashMap[K, V]) insert(e entry[K, V]) {
+	i := e.hash & m.mask
+	e.dist = 0
+	for {
+		s := &m.slots[i]
+		if !s.used {
+			*s = e
+			m.size++
+			return
+		}
+		// 劫富济贫：当前槽位的元素离理想位置更近，把位置让给探测距离更远的元素
+		if s.dist < e.dist {
+			*s, e = e, *s
+		}
+		i = (i + 1) & m.mask
+		e.dist++
+	}
+}
+
+// resize 调整槽位数量并重新插入所有元素
+func (m *HashMap[K, V]) resize(capacity int) {
+	old := m.slots
+	m.slots = make([]entry[K, V], capacity)
+	m.mask = uint64(capacity - 1)
+	m.size = 0
+	for i := range old {
+		if old[i].used {
+			m.insert(old[i])
+		}
+	}
+}
+
+// mix 对用户提供的哈希值再做一次打散（murmur3的fmix64），避免低位分布不均导致聚集
+func mix(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+// roundUpPowerOfTwo 向上取整到2的幂，最小为默认容量
+func roundUpPowerOfTwo(n int) int {
+	c := defaultCapacity
+	for c < n {
+		c <<= 1
+	}
+	return c
+}
@@ -0,0 +1,121 @@
+package hashmap
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// point 含有切片的结构体，无法作为内置map的key
+type point struct {
+	name   string
+	coords []int
+}
+
+func pointHasher(p point) uint64 {
+	h := pkg.StringHasher(p.name)
+	for _, c := range p.coords {
+		h = h*31 + uint64(c)
+	}
+	return h
+}
+
+func pointComparator(a, b point) int {
+	if c := strings.Compare(a.name, b.name); c != 0 {
+		return c
+	}
+	if len(a.coords) != len(b.coords) {
+		return len(a.coords) - len(b.coords)
+	}
+	for i := range a.coords {
+		if a.coords[i] != b.coords[i] {
+			return a.coords[i] - b.coords[i]
+		}
+	}
+	return 0
+}
+
+func TestHashMap_SliceKey(t *testing.T) {
+	m := New[point, int](pointHasher, pointComparator)
+	m.Put(point{name: "a", coords: []int{1, 2}}, 1)
+	m.Put(point{name: "a", coords: []int{1, 2, 3}}, 2)
+	m.Put(point{name: "a", coords: []int{1, 2}}, 3)
+
+	if m.Size() != 2 {
+		t.Errorf("Size() = %d, want 2", m.Size())
+	}
+	if v, ok := m.Get(point{name: "a", coords: []int{1, 2}}); !ok || v != 3 {
+		t.Errorf("Get() = %d, %v, want 3, true", v, ok)
+	}
+	if m.Contains(point{name: "b", coords: []int{1, 2}}) {
+		t.Errorf("Contains() of missing key")
+	}
+	if v, ok := m.Remove(point{name: "a", coords: []int{1, 2, 3}}); !ok || v != 2 {
+		t.Errorf("Remove() = %d, %v, want 2, true", v, ok)
+	}
+	if m.Size() != 1 {
+		t.Errorf("Size() = %d, want 1", m.Size())
+	}
+}
+
+func TestHashMap_Resize(t *testing.T) {
+	m := NewWithLoadFactor[int, int](0, 0.5, pkg.IntHasher[int], pkg.NumberComparator[int])
+	for i := 0; i < 1000; i++ {
+		m.Put(i, i*i)
+	}
+	if float64(m.Size()) > float64(m.Capacity())*0.5 {
+		t.Errorf("load factor exceeded: size=%d capacity=%d", m.Size(), m.Capacity())
+	}
+	grown := m.Capacity()
+	for i := 0; i < 990; i++ {
+		if v, ok := m.Remove(i); !ok || v != i*i {
+			t.Fatalf("Remove(%d) = %d, %v", i, v, ok)
+		}
+	}
+	if m.Capacity() >= grown {
+		t.Errorf("Capacity() = %d, want shrink below %d", m.Capacity(), grown)
+	}
+	for i := 990; i < 1000; i++ {
+		if v, ok := m.Get(i); !ok || v != i*i {
+			t.Errorf("Get(%d) = %d, %v", i, v, ok)
+		}
+	}
+}
+
+func TestHashMap_Random(t *testing.T) {
+	m := New[int, int](pkg.IntHasher[int], pkg.NumberComparator[int])
+	ref := make(map[int]int)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		k := r.Intn(2000)
+		switch r.Intn(3) {
+		case 0:
+			_, want := ref[k]
+			delete(ref, k)
+			if _, ok := m.Remove(k); ok != want {
+				t.Fatalf("Remove(%d) = %v, want %v", k, ok, want)
+			}
+		default:
+			ref[k] = i
+			m.Put(k, i)
+		}
+	}
+
+	if m.Size() != len(ref) {
+		t.Fatalf("Size() = %d, want %d", m.Size(), len(ref))
+	}
+	for k, v := range ref {
+		if got, ok := m.Get(k); !ok || got != v {
+			t.Fatalf("Get(%d) = %d, %v, want %d", k, got, ok, v)
+		}
+	}
+	keys := m.Keys()
+	sort.Ints(keys)
+	for i := 1; i < len(keys); i++ {
+		if keys[i] == keys[i-1] {
+			t.Fatalf("duplicate key %d", keys[i])
+		}
+	}
+}
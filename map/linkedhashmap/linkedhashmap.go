@@ -0,0 +1,173 @@
+package linkedhashmap
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/list/doublylinkedlist"
+	"github.com/dairongpeng/ds/map/hashmap"
+	"github.com/dairongpeng/ds/pkg"
+)
+
+// Order 链表维护的顺序
+type Order int
+
+const (
+	// InsertionOrder 按插入顺序排列，覆盖已存在的key不改变顺序
+	InsertionOrder Order = iota
+	// AccessOrder 按访问顺序排列，Put和Get都会把元素移动到链表末尾，链表头部是最久未被访问的元素
+	AccessOrder
+)
+
+// entry 链表节点中保存的键值对
+type entry[K any, V any] struct {
+	key   K
+	value V
+}
+
+// LinkedHashMap 哈希表加双向链表实现的有序Map
+// 哈希表负责O(1)的查找，双向链表负责维护顺序，并支持O(1)的删除和移动
+type LinkedHashMap[K any, V any] struct {
+	index *hashmap.HashMap[K, *doublylinkedlist.Node[entry[K, V]]]
+	// 链表头是最老的元素，链表尾是最新的元素
+	list  *doublylinkedlist.List[entry[K, V]]
+	order Order
+}
+
+// New 初始化一个LinkedHashMap，order指定按插入顺序还是按访问顺序
+func New[K any, V any](order Order, hasher pkg.Hasher[K], comparator pkg.Comparator[K]) *LinkedHashMap[K, V] {
+	return &LinkedHashMap[K, V]{
+		index: hashmap.New[K, *doublylinkedlist.Node[entry[K, V]]](hasher, comparator),
+		list:  doublylinkedlist.New[entry[K, V]](),
+		order: order,
+	}
+}
+
+// Put 添加一个键值对，如果key已经存在则覆盖原来的value
+// 按访问顺序排列时，被覆盖的元素会移动到链表末尾
+func (m *LinkedHashMap[K, V]) Put(key K, value V) {
+	if n, ok := m.index.Get(key); ok {
+		n.Value.value = value
+		m.afterAccess(n)
+		return
+	}
+	m.index.Put(key, m.list.PushBack(entry[K, V]{key: key, value: value}))
+}
+
+// Get 通过key获取value，如果key不存在则返回一个零值和false
+// 按访问顺序排列时，被访问的元素会移动到链表末尾
+func (m *LinkedHashMap[K, V]) Get(key K) (V, bool) {
+	n, ok := m.index.Get(key)
+	if !ok {
+		var zeroValue V
+		return zeroValue, false
+	}
+	m.afterAccess(n)
+	return n.Value.value, true
+}
+
+// Peek 通过key获取value，不改变元素的顺序
+func (m *LinkedHashMap[K, V]) Peek(key K) (V, bool) {
+	n, ok := m.index.Get(key)
+	if !ok {
+		var zeroValue V
+		return zeroValue, false
+	}
+	return n.Value.value, true
+}
+
+// Remove 删除key对应的键值对，返回被删除的value。如果key不存在则返回一个零值和false
+func (m *LinkedHashMap[K, V]) Remove(key K) (V, bool) {
+	n, ok := m.index.Remove(key)
+	if !ok {
+		var zeroValue V
+		return zeroValue, false
+	}
+	m.list.RemoveNode(n)
+	return n.Value.value, true
+}
+
+// Contains 判断key是否存在，不改变元素的顺序
+func (m *LinkedHashMap[K, V]) Contains(key K) bool {
+	return m.index.Contains(key)
+}
+
+// Size 返回键值对的个数
+func (m *LinkedHashMap[K, V]) Size() int {
+	return m.index.Size()
+}
+
+// IsEmpty 判断Map是否为空
+func (m *LinkedHashMap[K, V]) IsEmpty() bool {
+	return m.index.IsEmpty()
+}
+
+// Clear 清空Map
+func (m *LinkedHashMap[K, V]) Clear() {
+	m.index.Clear()
+	m.list = doublylinkedlist.New[entry[K, V]]()
+}
+
+// Eldest 返回链表头部的键值对，即最早插入或最久未被访问的元素。不改变元素的顺序
+func (m *LinkedHashMap[K, V]) Eldest() (K, V, bool) {
+	n := m.list.Head()
+	if n == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return n.Value.key, n.Value.value, true
+}
+
+// RemoveEldest 删除并返回链表头部的键值对
+func (m *LinkedHashMap[K, V]) RemoveEldest() (K, V, bool) {
+	e, ok := m.list.PopFront()
+	if !ok {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	m.index.Remove(e.key)
+	return e.key, e.value, true
+}
+
+// Keys 按链表顺序返回所有的key
+func (m *LinkedHashMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Size())
+	for n := m.list.Head(); n != nil; n = n.Next() {
+		keys = append(keys, n.Value.key)
+	}
+	return keys
+}
+
+// Values 按链表顺序返回所有的value
+func (m *LinkedHashMap[K, V]) Values() []V {
+	values := make([]V, 0, m.Size())
+	for n := m.list.Head(); n != nil; n = n.Next() {
+		values = append(values, n.Value.value)
+	}
+	return values
+}
+
+// Each 按链表顺序遍历所有键值对，f返回false时提前终止遍历。遍历不改变元素的顺序
+func (m *LinkedHashMap[K, V]) Each(f func(key K, value V) bool) {
+	for n := m.list.Head(); n != nil; n = n.Next() {
+		if !f(n.Value.key, n.Value.value) {
+			return
+		}
+	}
+}
+
+// Print 按链表顺序打印Map
+func (m *LinkedHashMap[K, V]) Print() {
+	fmt.Println("Linked Hash Map: ")
+	for n := m.list.Head(); n != nil; n = n.Next() {
+		fmt.Print(n.Value.key, ":", n.Value.value, " ")
+	}
+	fmt.Println()
+}
+
+// afterAccess 按访问顺序排列时，把n移动到链表末尾
+func (m *LinkedHashMap[K, V]) afterAccess(n *doublylinkedlist.Node[entry[K, V]]) {
+	if m.order == AccessOrder {
+		m.list.MoveToBack(n)
+	}
+}
@@ -0,0 +1,56 @@
+package linkedhashmap
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"reflect"
+	"testing"
+)
+
+func TestLinkedHashMap_InsertionOrder(t *testing.T) {
+	m := New[string, int](InsertionOrder, pkg.StringHasher, pkg.StringComparator)
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 30)
+	m.Get("a")
+
+	if got := m.Keys(); !reflect.DeepEqual(got, []string{"c", "a", "b"}) {
+		t.Errorf("Keys() = %v", got)
+	}
+	if got := m.Values(); !reflect.DeepEqual(got, []int{30, 1, 2}) {
+		t.Errorf("Values() = %v", got)
+	}
+
+	m.Remove("a")
+	if k, v, ok := m.RemoveEldest(); !ok || k != "c" || v != 30 {
+		t.Errorf("RemoveEldest() = %v, %v, %v", k, v, ok)
+	}
+	if got := m.Keys(); !reflect.DeepEqual(got, []string{"b"}) {
+		t.Errorf("Keys() = %v", got)
+	}
+}
+
+func TestLinkedHashMap_AccessOrder(t *testing.T) {
+	m := New[string, int](AccessOrder, pkg.StringHasher, pkg.StringComparator)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	m.Put("c", 3)
+	m.Get("a")
+	m.Put("b", 20)
+	m.Peek("c")
+
+	if got := m.Keys(); !reflect.DeepEqual(got, []string{"c", "a", "b"}) {
+		t.Errorf("Keys() = %v", got)
+	}
+	if k, _, ok := m.Eldest(); !ok || k != "c" {
+		t.Errorf("Eldest() = %v, %v", k, ok)
+	}
+
+	m.Clear()
+	if !m.IsEmpty() || len(m.Keys()) != 0 {
+		t.Errorf("Clear() left %v", m.Keys())
+	}
+	if _, _, ok := m.RemoveEldest(); ok {
+		t.Errorf("RemoveEldest() on empty map")
+	}
+}
@@ -0,0 +1,95 @@
+package lrucache
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/map/linkedhashmap"
+	"github.com/dairongpeng/ds/pkg"
+)
+
+// LRUCache 最近最少使用缓存，基于按访问顺序排列的LinkedHashMap实现
+// 链表头部是最久未被使用的元素，容量满时从头部淘汰
+type LRUCache[K any, V any] struct {
+	m        *linkedhashmap.LinkedHashMap[K, V]
+	capacity int
+	// 元素因容量不足被淘汰时的回调
+	onEvict func(key K, value V)
+}
+
+// New 初始化一个容量为capacity的LRU缓存，capacity小于1时按1处理
+func New[K any, V any](capacity int, hasher pkg.Hasher[K], comparator pkg.Comparator[K]) *LRUCache[K, V] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRUCache[K, V]{
+		m:        linkedhashmap.New[K, V](linkedhashmap.AccessOrder, hasher, comparator),
+		capacity: capacity,
+	}
+}
+
+// OnEvict 设置淘汰回调，元素因容量不足被淘汰时调用。主动Remove的元素不会触发回调
+func (c *LRUCache[K, V]) OnEvict(f func(key K, value V)) {
+	c.onEvict = f
+}
+
+// Get 获取key对应的value，并把key标记为最近使用
+func (c *LRUCache[K, V]) Get(key K) (V, bool) {
+	return c.m.Get(key)
+}
+
+// Peek 获取key对应的value，不改变key的使用顺序
+func (c *LRUCache[K, V]) Peek(key K) (V, bool) {
+	return c.m.Peek(key)
+}
+
+// Put 添加或更新一个键值对，并把key标记为最近使用。容量不足时淘汰最久未使用的元素，发生淘汰时返回true
+func (c *LRUCache[K, V]) Put(key K, value V) bool {
+	c.m.Put(key, value)
+	if c.m.Size() <= c.capacity {
+		return false
+	}
+	k, v, _ := c.m.RemoveEldest()
+	if c.onEvict != nil {
+		c.onEvict(k, v)
+	}
+	return true
+}
+
+// Remove 删除key对应的元素，返回被删除的value
+func (c *LRUCache[K, V]) Remove(key K) (V, bool) {
+	return c.m.Remove(key)
+}
+
+// Contains 判断key是否在缓存中，不改变key的使用顺序
+func (c *LRUCache[K, V]) Contains(key K) bool {
+	return c.m.Contains(key)
+}
+
+// Size 返回缓存中元素的个数
+func (c *LRUCache[K, V]) Size() int {
+	return c.m.Size()
+}
+
+// Capacity 返回缓存的容量
+func (c *LRUCache[K, V]) Capacity() int {
+	return c.capacity
+}
+
+// Keys 按从最久未使用到最近使用的顺序返回所有的key
+func (c *LRUCache[K, V]) Keys() []K {
+	return c.m.Keys()
+}
+
+// Clear 清空缓存，不会触发淘汰回调
+func (c *LRUCache[K, V]) Clear() {
+	c.m.Clear()
+}
+
+// Print 按从最久未使用到最近使用的顺序打印缓存
+func (c *LRUCache[K, V]) Print() {
+	fmt.Println("LRU Cache: ")
+	c.m.Each(func(key K, value V) bool {
+		fmt.Print(key, ":", value, " ")
+		return true
+	})
+	fmt.Println()
+}
@@ -0,0 +1,49 @@
+package lrucache
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"reflect"
+	"testing"
+)
+
+func TestLRUCache(t *testing.T) {
+	c := New[int, string](2, pkg.IntHasher[int], pkg.NumberComparator[int])
+	evicted := make([]int, 0)
+	c.OnEvict(func(key int, value string) {
+		evicted = append(evicted, key)
+	})
+
+	c.Put(1, "one")
+	c.Put(2, "two")
+	// 1变为最近使用，2成为最久未使用
+	if v, ok := c.Get(1); !ok || v != "one" {
+		t.Errorf("Get(1) = %v, %v", v, ok)
+	}
+	if !c.Put(3, "three") {
+		t.Errorf("Put(3) should evict")
+	}
+	if c.Contains(2) {
+		t.Errorf("2 should be evicted")
+	}
+
+	// Peek不改变顺序，1仍是最久未使用
+	c.Peek(1)
+	c.Put(4, "four")
+	if c.Contains(1) {
+		t.Errorf("1 should be evicted")
+	}
+	if !reflect.DeepEqual(evicted, []int{2, 1}) {
+		t.Errorf("evicted = %v, want [2 1]", evicted)
+	}
+
+	// 主动删除不触发回调
+	if v, ok := c.Remove(3); !ok || v != "three" {
+		t.Errorf("Remove(3) = %v, %v", v, ok)
+	}
+	if len(evicted) != 2 {
+		t.Errorf("Remove should not call OnEvict")
+	}
+	if !reflect.DeepEqual(c.Keys(), []int{4}) || c.Size() != 1 || c.Capacity() != 2 {
+		t.Errorf("Keys() = %v, Size() = %d", c.Keys(), c.Size())
+	}
+}
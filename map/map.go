@@ -1 +1,12 @@
 package dsmap
+
+// DSMap 键值对映射结构
+type DSMap[K any, V any] interface {
+	Put(key K, value V)
+	Get(key K) (V, bool)
+	Remove(key K) (V, bool)
+	Contains(key K) bool
+	Size() int
+	Keys() []K
+	Values() []V
+}
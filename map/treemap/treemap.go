@@ -0,0 +1,165 @@
+package treemap
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/pkg"
+	dstree "github.com/dairongpeng/ds/tree"
+	"github.com/dairongpeng/ds/tree/rbtree"
+)
+
+// entry 树中保存的键值对，只按key比较
+type entry[K any, V any] struct {
+	key   K
+	value V
+}
+
+// TreeMap 基于红黑树实现的有序Map，key的顺序由比较器决定
+// 底层直接复用rbtree.Tree，树中的元素是键值对，比较时只比较key，增删改查的时间复杂度都是O(logN)
+type TreeMap[K any, V any] struct {
+	tree *rbtree.Tree[entry[K, V]]
+}
+
+// New 初始化一个有序Map，comparator决定key的顺序
+func New[K any, V any](comparator pkg.Comparator[K]) *TreeMap[K, V] {
+	return &TreeMap[K, V]{
+		tree: rbtree.New[entry[K, V]](func(a, b entry[K, V]) int {
+			return comparator(a.key, b.key)
+		}),
+	}
+}
+
+// Put 添加一个键值对，如果key已经存在则覆盖原来的value
+func (m *TreeMap[K, V]) Put(key K, value V) {
+	m.tree.Insert(entry[K, V]{key: key, value: value})
+}
+
+// Get 通过key获取value，如果key不存在则返回一个零值和false
+func (m *TreeMap[K, V]) Get(key K) (V, bool) {
+	e, ok := m.tree.Get(entry[K, V]{key: key})
+	return e.value, ok
+}
+
+// Remove 删除key对应的键值对，返回被删除的value。如果key不存在则返回一个零值和false
+func (m *TreeMap[K, V]) Remove(key K) (V, bool) {
+	e, ok := m.tree.Get(entry[K, V]{key: key})
+	if !ok {
+		return e.value, false
+	}
+	m.tree.Delete(e)
+	return e.value, true
+}
+
+// Contains 判断key是否存在
+func (m *TreeMap[K, V]) Contains(key K) bool {
+	return m.tree.Search(entry[K, V]{key: key})
+}
+
+// Size 返回键值对的个数
+func (m *TreeMap[K, V]) Size() int {
+	return m.tree.Size()
+}
+
+// IsEmpty 判断Map是否为空
+func (m *TreeMap[K, V]) IsEmpty() bool {
+	return m.tree.IsEmpty()
+}
+
+// Clear 清空Map
+func (m *TreeMap[K, V]) Clear() {
+	m.tree.Clear()
+}
+
+// Keys 按从小到大的顺序返回所有的key
+func (m *TreeMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.tree.Size())
+	it := m.tree.Iterator()
+	for it.Next() {
+		keys = append(keys, it.Value().key)
+	}
+	return keys
+}
+
+// Values 按key从小到大的顺序返回所有的value
+func (m *TreeMap[K, V]) Values() []V {
+	values := make([]V, 0, m.tree.Size())
+	it := m.tree.Iterator()
+	for it.Next() {
+		values = append(values, it.Value().value)
+	}
+	return values
+}
+
+// Each 按key从小到大的顺序遍历所有键值对，f返回false时提前终止遍历
+func (m *TreeMap[K, V]) Each(f func(key K, value V) bool) {
+	it := m.tree.Iterator()
+	for it.Next() {
+		if e := it.Value(); !f(e.key, e.value) {
+			return
+		}
+	}
+}
+
+// Min 返回最小的key及其value，Map为空时返回false
+func (m *TreeMap[K, V]) Min() (K, V, bool) {
+	return unpack(m.tree.Min())
+}
+
+// Max 返回最大的key及其value，Map为空时返回false
+func (m *TreeMap[K, V]) Max() (K, V, bool) {
+	return unpack(m.tree.Max())
+}
+
+// Floor 返回小于等于key的最大的key及其value，不存在时返回false
+func (m *TreeMap[K, V]) Floor(key K) (K, V, bool) {
+	return unpack(m.tree.Floor(entry[K, V]{key: key}))
+}
+
+// Ceiling 返回大于等于key的最小的key及其value，不存在时返回false
+func (m *TreeMap[K, V]) Ceiling(key K) (K, V, bool) {
+	return unpack(m.tree.Ceiling(entry[K, V]{key: key}))
+}
+
+// Iterator 返回一个按key从小到大顺序的迭代器
+func (m *TreeMap[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{it: m.tree.Iterator()}
+}
+
+// Range 返回key在闭区间[lo, hi]内的键值对的有序迭代器
+func (m *TreeMap[K, V]) Range(lo, hi K) *Iterator[K, V] {
+	return &Iterator[K, V]{it: m.tree.Range(entry[K, V]{key: lo}, entry[K, V]{key: hi})}
+}
+
+// Print 按key的顺序打印Map
+func (m *TreeMap[K, V]) Print() {
+	fmt.Println("Tree Map: ")
+	it := m.tree.Iterator()
+	for it.Next() {
+		e := it.Value()
+		fmt.Print(e.key, ":", e.value, " ")
+	}
+	fmt.Println()
+}
+
+// Iterator TreeMap的有序迭代器，迭代期间不能修改Map
+type Iterator[K any, V any] struct {
+	it dstree.Iterator[entry[K, V]]
+}
+
+// Next 移动到下一个键值对，没有更多元素时返回false
+func (it *Iterator[K, V]) Next() bool {
+	return it.it.Next()
+}
+
+// Key 返回当前位置的key
+func (it *Iterator[K, V]) Key() K {
+	return it.it.Value().key
+}
+
+// Value 返回当前位置的value
+func (it *Iterator[K, V]) Value() V {
+	return it.it.Value().value
+}
+
+func unpack[K any, V any](e entry[K, V], ok bool) (K, V, bool) {
+	return e.key, e.value, ok
+}
@@ -0,0 +1,131 @@
+package treemap
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestTreeMap_PutGetRemove(t *testing.T) {
+	m := New[int, string](pkg.NumberComparator[int])
+	m.Put(5, "e")
+	m.Put(1, "a")
+	m.Put(3, "c")
+	m.Put(3, "cc")
+
+	if m.Size() != 3 {
+		t.Errorf("Size() = %d, want 3", m.Size())
+	}
+	if v, ok := m.Get(3); !ok || v != "cc" {
+		t.Errorf("Get(3) = %v, %v, want cc, true", v, ok)
+	}
+	if _, ok := m.Get(4); ok {
+		t.Errorf("Get(4) should not exist")
+	}
+	if !reflect.DeepEqual(m.Keys(), []int{1, 3, 5}) {
+		t.Errorf("Keys() = %v", m.Keys())
+	}
+	if !reflect.DeepEqual(m.Values(), []string{"a", "cc", "e"}) {
+		t.Errorf("Values() = %v", m.Values())
+	}
+	if v, ok := m.Remove(1); !ok || v != "a" {
+		t.Errorf("Remove(1) = %v, %v, want a, true", v, ok)
+	}
+	if m.Contains(1) {
+		t.Errorf("Contains(1) after Remove")
+	}
+	if _, ok := m.Remove(1); ok {
+		t.Errorf("Remove(1) twice should fail")
+	}
+}
+
+func TestTreeMap_FloorCeiling(t *testing.T) {
+	m := New[int, int](pkg.NumberComparator[int])
+	for _, k := range []int{10, 20, 30, 40} {
+		m.Put(k, k*10)
+	}
+
+	type testCase struct {
+		name    string
+		key     int
+		floor   int
+		floorOk bool
+		ceil    int
+		ceilOk  bool
+	}
+	tests := []testCase{
+		{name: "below_min", key: 5, floorOk: false, ceil: 10, ceilOk: true},
+		{name: "exact", key: 20, floor: 20, floorOk: true, ceil: 20, ceilOk: true},
+		{name: "between", key: 25, floor: 20, floorOk: true, ceil: 30, ceilOk: true},
+		{name: "above_max", key: 45, floor: 40, floorOk: true, ceilOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if k, _, ok := m.Floor(tt.key); ok != tt.floorOk || (ok && k != tt.floor) {
+				t.Errorf("Floor(%d) = %d, %v", tt.key, k, ok)
+			}
+			if k, _, ok := m.Ceiling(tt.key); ok != tt.ceilOk || (ok && k != tt.ceil) {
+				t.Errorf("Ceiling(%d) = %d, %v", tt.key, k, ok)
+			}
+		})
+	}
+
+	if k, v, ok := m.Min(); !ok || k != 10 || v != 100 {
+		t.Errorf("Min() = %d, %d, %v", k, v, ok)
+	}
+	if k, v, ok := m.Max(); !ok || k != 40 || v != 400 {
+		t.Errorf("Max() = %d, %d, %v", k, v, ok)
+	}
+
+	var keys []int
+	for it := m.Range(15, 40); it.Next(); {
+		if it.Value() != it.Key()*10 {
+			t.Errorf("Range() value of %d = %d", it.Key(), it.Value())
+		}
+		keys = append(keys, it.Key())
+	}
+	if !reflect.DeepEqual(keys, []int{20, 30, 40}) {
+		t.Errorf("Range(15, 40) = %v", keys)
+	}
+}
+
+func TestTreeMap_Random(t *testing.T) {
+	m := New[int, int](pkg.NumberComparator[int])
+	ref := make(map[int]int)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		k := r.Intn(500)
+		if r.Intn(3) == 0 {
+			_, want := ref[k]
+			delete(ref, k)
+			if _, ok := m.Remove(k); ok != want {
+				t.Fatalf("Remove(%d) = %v, want %v", k, ok, want)
+			}
+		} else {
+			ref[k] = i
+			m.Put(k, i)
+		}
+		if m.Size() != len(ref) {
+			t.Fatalf("Size() = %d, want %d", m.Size(), len(ref))
+		}
+	}
+
+	keys := make([]int, 0, len(ref))
+	for k := range ref {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	got := make([]int, 0, m.Size())
+	it := m.Iterator()
+	for it.Next() {
+		if ref[it.Key()] != it.Value() {
+			t.Fatalf("value of %d = %d, want %d", it.Key(), it.Value(), ref[it.Key()])
+		}
+		got = append(got, it.Key())
+	}
+	if !reflect.DeepEqual(got, keys) {
+		t.Errorf("iteration order mismatch")
+	}
+}
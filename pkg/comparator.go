@@ -1,5 +1,7 @@
 package pkg
 
+import "strings"
+
 // Comparator 比较器
 // if item1 < item2时, 返回负数
 // if item1 == item2时，返回0
@@ -16,3 +18,8 @@ func NumberComparator[T int | int32 | int64 | float32 | float64](a, b T) int {
 		return 1
 	}
 }
+
+// StringComparator 字符串类型的比较器，按字典序比较
+func StringComparator(a, b string) int {
+	return strings.Compare(a, b)
+}
@@ -0,0 +1,19 @@
+package pkg
+
+import "hash/fnv"
+
+// Hasher 哈希函数
+// 如果comparator(item1, item2) == 0，那么必须满足hasher(item1) == hasher(item2)
+type Hasher[T any] func(item T) uint64
+
+// StringHasher 字符串类型的哈希函数，基于fnv-1a实现
+func StringHasher(s string) uint64 {
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(s))
+	return hash.Sum64()
+}
+
+// IntHasher 整数类型的哈希函数，直接使用整数本身，由使用方自行打散
+func IntHasher[T int | int8 | int16 | int32 | int64 | uint | uint8 | uint16 | uint32 | uint64](v T) uint64 {
+	return uint64(v)
+}
@@ -21,12 +21,15 @@ func (q *Queue[T]) Enqueue(v T) {
 }
 
 // Dequeue 从队头弹出一个元素，如果队列为空则返回一个零值和false
+// 出队只是重新切片，底层数组要等到下次扩容才会释放。长期使用的队列请使用ringqueue
 func (q *Queue[T]) Dequeue() (T, bool) {
+	var zeroValue T
 	if len(*q) == 0 {
-		var zeroValue T
 		return zeroValue, false
 	}
 	val := (*q)[0]
+	// 清除出队槽位的引用，使元素可以被回收
+	(*q)[0] = zeroValue
 	*q = (*q)[1:]
 	return val, true
 }
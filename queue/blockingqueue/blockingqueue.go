@@ -0,0 +1,159 @@
+package blockingqueue
+
+import (
+	"context"
+	"fmt"
+	"github.com/dairongpeng/ds/queue/deque"
+	"github.com/dairongpeng/ds/queue/internal/notify"
+	"sync"
+)
+
+// ErrClosed 队列已关闭，与delayqueue.ErrClosed是同一个值
+var ErrClosed = notify.ErrClosed
+
+// Queue 有界阻塞队列，并发安全，适用于生产者消费者模型
+// 队列满时入队阻塞，队列空时出队阻塞。Offer/Poll可以通过context设置超时或取消等待
+// 关闭后不能再入队，队列中剩余的元素仍然可以出队，取完之后出队立即返回
+//
+// 注意：Enqueue（即DSQueue接口的入队方法）没有返回值，队列关闭后入队的元素，以及关闭时仍阻塞在Enqueue中的元素，
+// 都会被直接丢弃而不会有任何提示。生产者需要感知关闭时必须使用Offer，它在队列关闭时返回ErrClosed
+type Queue[T any] struct {
+	mu       sync.Mutex
+	items    *deque.Deque[T]
+	capacity int
+	// 等待队列非空/非满的通知，有等待者时才创建，状态变化时关闭以唤醒所有等待者
+	notEmpty chan struct{}
+	notFull  chan struct{}
+	// 关闭队列时唤醒所有等待者
+	closer *notify.Closer
+}
+
+// New 初始化一个容量为capacity的阻塞队列，capacity小于1时按1处理
+func New[T any](capacity int) *Queue[T] {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &Queue[T]{
+		items:    deque.New[T](),
+		capacity: capacity,
+		closer:   notify.NewCloser(),
+	}
+}
+
+// Enqueue 从队尾加入一个元素，队列满时阻塞直到有空位
+// 队列关闭后元素会被静默丢弃，需要感知关闭时请使用Offer
+func (q *Queue[T]) Enqueue(v T) {
+	_ = q.Offer(context.Background(), v)
+}
+
+// Dequeue 从队头弹出一个元素，队列空时阻塞直到有元素。队列关闭并且已经取空时返回一个零值和false
+func (q *Queue[T]) Dequeue() (T, bool) {
+	v, err := q.Poll(context.Background())
+	return v, err == nil
+}
+
+// Offer 从队尾加入一个元素，队列满时阻塞
+// ctx被取消时返回ctx.Err()，队列关闭时返回ErrClosed
+func (q *Queue[T]) Offer(ctx context.Context, v T) error {
+	for {
+		q.mu.Lock()
+		if q.closer.IsClosed() {
+			q.mu.Unlock()
+			return ErrClosed
+		}
+		if q.items.Size() < q.capacity {
+			q.items.Enqueue(v)
+			q.notEmpty = notify.Broadcast(q.notEmpty)
+			q.mu.Unlock()
+			return nil
+		}
+		if q.notFull == nil {
+			q.notFull = make(chan struct{})
+		}
+		wait := q.notFull
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-q.closer.Done():
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Poll 从队头弹出一个元素，队列空时阻塞
+// ctx被取消时返回ctx.Err()，队列关闭并且已经取空时返回ErrClosed
+func (q *Queue[T]) Poll(ctx context.Context) (T, error) {
+	var zeroValue T
+	for {
+		q.mu.Lock()
+		if v, ok := q.items.Dequeue(); ok {
+			q.notFull = notify.Broadcast(q.notFull)
+			q.mu.Unlock()
+			return v, nil
+		}
+		if q.closer.IsClosed() {
+			q.mu.Unlock()
+			return zeroValue, ErrClosed
+		}
+		if q.notEmpty == nil {
+			q.notEmpty = make(chan struct{})
+		}
+		wait := q.notEmpty
+		q.mu.Unlock()
+
+		select {
+		case <-wait:
+		case <-q.closer.Done():
+		case <-ctx.Done():
+			return zeroValue, ctx.Err()
+		}
+	}
+}
+
+// Close 关闭队列并唤醒所有等待者，重复关闭无副作用
+func (q *Queue[T]) Close() {
+	q.closer.Close()
+}
+
+// IsClosed 判断队列是否已关闭
+func (q *Queue[T]) IsClosed() bool {
+	return q.closer.IsClosed()
+}
+
+// Front 查看队头的元素，不出队也不阻塞。如果队列为空则返回一个零值和false
+func (q *Queue[T]) Front() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Front()
+}
+
+// Size 返回队列的元素个数
+func (q *Queue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Size()
+}
+
+// IsEmpty 判断队列是否为空
+func (q *Queue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Capacity 返回队列的容量
+func (q *Queue[T]) Capacity() int {
+	return q.capacity
+}
+
+// Print 从队头到队尾打印队列的元素
+func (q *Queue[T]) Print() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	fmt.Println("Blocking Queue: ")
+	for _, v := range q.items.Values() {
+		fmt.Print(v, " ")
+	}
+
+	fmt.Println()
+}
@@ -0,0 +1,172 @@
+package blockingqueue
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestQueue_Blocking(t *testing.T) {
+	q := New[int](2)
+	q.Enqueue(1)
+	q.Enqueue(2)
+
+	// 队列已满，入队阻塞直到有元素出队
+	enqueued := make(chan struct{})
+	go func() {
+		q.Enqueue(3)
+		close(enqueued)
+	}()
+	select {
+	case <-enqueued:
+		t.Fatalf("Enqueue() should block on a full queue")
+	case <-time.After(20 * time.Millisecond):
+	}
+	if v, ok := q.Dequeue(); !ok || v != 1 {
+		t.Fatalf("Dequeue() = %d, %v", v, ok)
+	}
+	<-enqueued
+
+	q.Dequeue()
+	q.Dequeue()
+	// 队列已空，出队阻塞直到有元素入队
+	dequeued := make(chan int)
+	go func() {
+		v, _ := q.Dequeue()
+		dequeued <- v
+	}()
+	select {
+	case <-dequeued:
+		t.Fatalf("Dequeue() should block on an empty queue")
+	case <-time.After(20 * time.Millisecond):
+	}
+	q.Enqueue(4)
+	if v := <-dequeued; v != 4 {
+		t.Errorf("Dequeue() = %d, want 4", v)
+	}
+}
+
+func TestQueue_Context(t *testing.T) {
+	q := New[int](1)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := q.Poll(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Poll() error = %v", err)
+	}
+
+	q.Enqueue(1)
+	ctx, cancel = context.WithCancel(context.Background())
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+	}()
+	if err := q.Offer(ctx, 2); !errors.Is(err, context.Canceled) {
+		t.Errorf("Offer() error = %v", err)
+	}
+	// 已取消的ctx在有空位时仍然可以立即入队
+	q.Dequeue()
+	if err := q.Offer(ctx, 3); err != nil {
+		t.Errorf("Offer() error = %v", err)
+	}
+}
+
+func TestQueue_Close(t *testing.T) {
+	q := New[int](1)
+	q.Enqueue(1)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 4)
+	for i := 0; i < 2; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- q.Offer(context.Background(), 2)
+		}()
+	}
+	time.Sleep(10 * time.Millisecond)
+	q.Close()
+	q.Close()
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if !errors.Is(err, ErrClosed) {
+			t.Errorf("Offer() error = %v, want ErrClosed", err)
+		}
+	}
+
+	// 关闭后剩余元素仍然可以取出，取空后不再阻塞
+	if v, ok := q.Dequeue(); !ok || v != 1 {
+		t.Errorf("Dequeue() = %d, %v", v, ok)
+	}
+	if _, ok := q.Dequeue(); ok {
+		t.Errorf("Dequeue() on closed empty queue")
+	}
+	if _, err := q.Poll(context.Background()); !errors.Is(err, ErrClosed) {
+		t.Errorf("Poll() error = %v", err)
+	}
+	if !q.IsClosed() {
+		t.Errorf("IsClosed() = false")
+	}
+
+	// 关闭后Enqueue不阻塞，元素被丢弃
+	q.Enqueue(3)
+	if q.Size() != 0 {
+		t.Errorf("Enqueue() after Close kept the item, Size() = %d", q.Size())
+	}
+}
+
+func TestQueue_ProducerConsumer(t *testing.T) {
+	const producers, consumers, perProducer = 4, 4, 2000
+	q := New[int](8)
+
+	var producerWg, consumerWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producerWg.Add(1)
+		go func(p int) {
+			defer producerWg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+
+	seen := make([][]int, consumers)
+	for c := 0; c < consumers; c++ {
+		consumerWg.Add(1)
+		go func(c int) {
+			defer consumerWg.Done()
+			for {
+				v, ok := q.Dequeue()
+				if !ok {
+					return
+				}
+				seen[c] = append(seen[c], v)
+			}
+		}(c)
+	}
+
+	producerWg.Wait()
+	q.Close()
+	consumerWg.Wait()
+
+	count := make([]int, producers*perProducer)
+	for _, values := range seen {
+		// 同一个生产者的元素按入队顺序被取出
+		last := make(map[int]int)
+		for _, v := range values {
+			count[v]++
+			p := v / perProducer
+			if prev, ok := last[p]; ok && prev > v {
+				t.Fatalf("producer %d out of order: %d after %d", p, v, prev)
+			}
+			last[p] = v
+		}
+	}
+	for v, c := range count {
+		if c != 1 {
+			t.Fatalf("value %d dequeued %d times", v, c)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package delayqueue
+
+import "time"
+
+// Clock 时间源，默认使用系统时间，测试时可以注入可控的实现
+type Clock interface {
+	// Now 返回当前时间
+	Now() time.Time
+	// NewTimer 返回一个经过d之后触发的计时器
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer 计时器，到期时向C()返回的channel发送当前时间
+type Timer interface {
+	C() <-chan time.Time
+	// Stop 停止计时器，计时器已经触发或已经停止时返回false
+	Stop() bool
+}
+
+// realClock 基于time包的系统时钟
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{timer: time.NewTimer(d)}
+}
+
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t realTimer) Stop() bool {
+	return t.timer.Stop()
+}
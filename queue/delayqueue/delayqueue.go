@@ -0,0 +1,206 @@
+package delayqueue
+
+import (
+	"context"
+	"fmt"
+	"github.com/dairongpeng/ds/heap/minheap"
+	"github.com/dairongpeng/ds/queue/internal/notify"
+	"sync"
+	"time"
+)
+
+// ErrClosed 队列已关闭，与blockingqueue.ErrClosed是同一个值
+var ErrClosed = notify.ErrClosed
+
+// item 堆中的元素，按到期时间排序，到期时间相同时按入队顺序出队
+type item[T any] struct {
+	value T
+	at    time.Time
+	seq   uint64
+}
+
+// Queue 延迟队列，并发安全。每个元素带有到期时间，只有到期的元素才能出队，适用于重试退避、定时通知等场景
+// 元素保存在按到期时间排序的小根堆中，出队时等待堆顶元素到期，期间有更早到期的元素入队会重新计算等待时间
+// 关闭后不能再入队，已经到期的元素仍然可以出队，未到期的元素不再等待
+type Queue[T any] struct {
+	mu    sync.Mutex
+	items *minheap.MinHeap[item[T]]
+	clock Clock
+	// 下一个入队元素的序号
+	seq uint64
+	// 等待堆顶变化的通知，有等待者时才创建，堆顶变化时关闭以唤醒所有等待者
+	headChanged chan struct{}
+	// 关闭队列时唤醒所有等待者
+	closer *notify.Closer
+}
+
+// New 初始化一个使用系统时钟的延迟队列
+func New[T any]() *Queue[T] {
+	return NewWithClock[T](realClock{})
+}
+
+// NewWithClock 初始化一个使用clock作为时间源的延迟队列
+func NewWithClock[T any](clock Clock) *Queue[T] {
+	return &Queue[T]{
+		items: minheap.NewMaxHeap[item[T]](0, func(a, b item[T]) int {
+			if a.at.Before(b.at) {
+				return -1
+			}
+			if a.at.After(b.at) {
+				return 1
+			}
+			if a.seq < b.seq {
+				return -1
+			}
+			if a.seq > b.seq {
+				return 1
+			}
+			return 0
+		}),
+		clock:  clock,
+		closer: notify.NewCloser(),
+	}
+}
+
+// Enqueue 加入一个在at时刻到期的元素，队列关闭时返回ErrClosed
+func (q *Queue[T]) Enqueue(v T, at time.Time) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.closer.IsClosed() {
+		return ErrClosed
+	}
+	it := item[T]{value: v, at: at, seq: q.seq}
+	q.seq++
+	_ = q.items.Push(it)
+	// 新元素成为堆顶时，等待者需要按新的到期时间重新等待
+	if head, _ := q.items.Peek(); head.seq == it.seq {
+		q.headChanged = notify.Broadcast(q.headChanged)
+	}
+	return nil
+}
+
+// EnqueueAfter 加入一个经过delay之后到期的元素，队列关闭时返回ErrClosed
+func (q *Queue[T]) EnqueueAfter(v T, delay time.Duration) error {
+	return q.Enqueue(v, q.clock.Now().Add(delay))
+}
+
+// Dequeue 弹出最早到期的元素，没有到期的元素时阻塞。队列关闭并且没有到期的元素时返回一个零值和false
+func (q *Queue[T]) Dequeue() (T, bool) {
+	v, err := q.Poll(context.Background())
+	return v, err == nil
+}
+
+// TryDequeue 弹出最早到期的元素，不阻塞。没有到期的元素时返回一个零值和false
+func (q *Queue[T]) TryDequeue() (T, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	v, ok, _ := q.popExpired()
+	return v, ok
+}
+
+// Poll 弹出最早到期的元素，没有到期的元素时阻塞直到堆顶元素到期
+// ctx被取消时返回ctx.Err()，队列关闭并且没有到期的元素时返回ErrClosed
+func (q *Queue[T]) Poll(ctx context.Context) (T, error) {
+	var zeroValue T
+	for {
+		q.mu.Lock()
+		v, ok, wait := q.popExpired()
+		if ok {
+			q.mu.Unlock()
+			return v, nil
+		}
+		if q.closer.IsClosed() {
+			q.mu.Unlock()
+			return zeroValue, ErrClosed
+		}
+		if q.headChanged == nil {
+			q.headChanged = make(chan struct{})
+		}
+		changed := q.headChanged
+		q.mu.Unlock()
+
+		// 队列为空时只等待入队，不需要计时器
+		var timer Timer
+		var expired <-chan time.Time
+		if wait > 0 {
+			timer = q.clock.NewTimer(wait)
+			expired = timer.C()
+		}
+		select {
+		case <-expired:
+		case <-changed:
+		case <-q.closer.Done():
+		case <-ctx.Done():
+			if timer != nil {
+				timer.Stop()
+			}
+			return zeroValue, ctx.Err()
+		}
+		if timer != nil {
+			timer.Stop()
+		}
+	}
+}
+
+// Peek 查看最早到期的元素及其到期时间，不出队也不要求已经到期。如果队列为空则返回一个零值和false
+func (q *Queue[T]) Peek() (T, time.Time, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	head, ok := q.items.Peek()
+	return head.value, head.at, ok
+}
+
+// Close 关闭队列并唤醒所有等待者，重复关闭无副作用
+func (q *Queue[T]) Close() {
+	q.closer.Close()
+}
+
+// IsClosed 判断队列是否已关闭
+func (q *Queue[T]) IsClosed() bool {
+	return q.closer.IsClosed()
+}
+
+// Size 返回队列的元素个数，包括未到期的元素
+func (q *Queue[T]) Size() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.items.Size()
+}
+
+// IsEmpty 判断队列是否为空
+func (q *Queue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Print 按到期顺序打印队列的元素
+func (q *Queue[T]) Print() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	fmt.Println("Delay Queue: ")
+	items := make([]item[T], 0, q.items.Size())
+	for !q.items.IsEmpty() {
+		it := q.items.Pop()
+		items = append(items, it)
+		fmt.Print(it.value, " ")
+	}
+	// 放回堆中，序号不变，出队顺序也不变
+	for _, it := range items {
+		_ = q.items.Push(it)
+	}
+
+	fmt.Println()
+}
+
+// popExpired 堆顶元素已经到期时弹出，否则返回距离堆顶到期的时间，队列为空时等待时间为0。调用方需要持有锁
+func (q *Queue[T]) popExpired() (T, bool, time.Duration) {
+	var zeroValue T
+	head, ok := q.items.Peek()
+	if !ok {
+		return zeroValue, false, 0
+	}
+	if wait := head.at.Sub(q.clock.Now()); wait > 0 {
+		return zeroValue, false, wait
+	}
+	q.items.Pop()
+	return head.value, true, 0
+}
@@ -0,0 +1,267 @@
+package delayqueue
+
+import (
+	"context"
+	"errors"
+	"github.com/dairongpeng/ds/queue/blockingqueue"
+	"runtime"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock 手动推进的时钟，Advance时触发所有到期的计时器
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+type fakeTimer struct {
+	clock *fakeClock
+	at    time.Time
+	c     chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	t := &fakeTimer{clock: c, at: c.now.Add(d), c: make(chan time.Time, 1)}
+	c.timers = append(c.timers, t)
+	return t
+}
+
+// Advance 推进时钟并触发到期的计时器
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.timers[:0]
+	for _, t := range c.timers {
+		if t.at.After(c.now) {
+			pending = append(pending, t)
+		} else {
+			t.c <- c.now
+		}
+	}
+	c.timers = pending
+}
+
+// waitTimers 等待直到有n个计时器在等待触发，即出队的goroutine已经进入等待
+func (c *fakeClock) waitTimers(t *testing.T, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		c.mu.Lock()
+		count := len(c.timers)
+		c.mu.Unlock()
+		if count == n {
+			return
+		}
+		runtime.Gosched()
+	}
+	t.Fatalf("timed out waiting for %d timers", n)
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	c := t.clock
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, pending := range c.timers {
+		if pending == t {
+			c.timers = append(c.timers[:i], c.timers[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func TestQueue_Order(t *testing.T) {
+	clock := newFakeClock()
+	q := NewWithClock[string](clock)
+	_ = q.EnqueueAfter("c", 3*time.Second)
+	_ = q.EnqueueAfter("a", time.Second)
+	_ = q.EnqueueAfter("b1", 2*time.Second)
+	_ = q.EnqueueAfter("b2", 2*time.Second)
+	_ = q.Enqueue("now", clock.Now())
+
+	type testCase struct {
+		name    string
+		advance time.Duration
+		want    []string
+	}
+	tests := []testCase{
+		{name: "due_now", advance: 0, want: []string{"now"}},
+		{name: "first_second", advance: time.Second, want: []string{"a"}},
+		{name: "not_due", advance: 500 * time.Millisecond, want: nil},
+		{name: "same_deadline_fifo", advance: 500 * time.Millisecond, want: []string{"b1", "b2"}},
+		{name: "last", advance: time.Hour, want: []string{"c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock.Advance(tt.advance)
+			for _, want := range tt.want {
+				if v, ok := q.TryDequeue(); !ok || v != want {
+					t.Fatalf("TryDequeue() = %s, %v, want %s", v, ok, want)
+				}
+			}
+			if v, ok := q.TryDequeue(); ok {
+				t.Fatalf("TryDequeue() = %s, nothing should be due", v)
+			}
+		})
+	}
+	if !q.IsEmpty() {
+		t.Errorf("queue should be empty, size %d", q.Size())
+	}
+}
+
+func TestQueue_PollWaitsForDeadline(t *testing.T) {
+	clock := newFakeClock()
+	q := NewWithClock[int](clock)
+	_ = q.EnqueueAfter(1, 10*time.Second)
+
+	got := make(chan int)
+	go func() {
+		v, _ := q.Dequeue()
+		got <- v
+	}()
+	clock.waitTimers(t, 1)
+	clock.Advance(9 * time.Second)
+	select {
+	case v := <-got:
+		t.Fatalf("Dequeue() = %d before the deadline", v)
+	default:
+	}
+	clock.Advance(time.Second)
+	if v := <-got; v != 1 {
+		t.Errorf("Dequeue() = %d", v)
+	}
+}
+
+func TestQueue_EarlierItemWakesPoll(t *testing.T) {
+	clock := newFakeClock()
+	q := NewWithClock[int](clock)
+	_ = q.EnqueueAfter(2, time.Minute)
+
+	got := make(chan int)
+	go func() {
+		v, _ := q.Dequeue()
+		got <- v
+	}()
+	clock.waitTimers(t, 1)
+	// 更早到期的元素入队后，等待者按新的堆顶重新计时
+	_ = q.EnqueueAfter(1, time.Second)
+	clock.waitTimers(t, 1)
+	if _, at, _ := q.Peek(); !at.Equal(clock.Now().Add(time.Second)) {
+		t.Fatalf("Peek() deadline = %v", at)
+	}
+	clock.Advance(time.Second)
+	if v := <-got; v != 1 {
+		t.Errorf("Dequeue() = %d, want 1", v)
+	}
+	if q.Size() != 1 {
+		t.Errorf("Size() = %d", q.Size())
+	}
+}
+
+func TestQueue_EmptyPollWakesOnEnqueue(t *testing.T) {
+	q := NewWithClock[int](newFakeClock())
+	got := make(chan int)
+	go func() {
+		v, _ := q.Dequeue()
+		got <- v
+	}()
+	// 队列为空时不创建计时器，入队一个已经到期的元素即可唤醒
+	time.Sleep(10 * time.Millisecond)
+	_ = q.EnqueueAfter(7, 0)
+	if v := <-got; v != 7 {
+		t.Errorf("Dequeue() = %d", v)
+	}
+}
+
+func TestQueue_PollCancel(t *testing.T) {
+	clock := newFakeClock()
+	q := NewWithClock[int](clock)
+	_ = q.EnqueueAfter(1, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	errs := make(chan error)
+	go func() {
+		_, err := q.Poll(ctx)
+		errs <- err
+	}()
+	clock.waitTimers(t, 1)
+	cancel()
+	if err := <-errs; !errors.Is(err, context.Canceled) {
+		t.Errorf("Poll() error = %v", err)
+	}
+	// 取消后计时器被停止
+	clock.waitTimers(t, 0)
+	if q.Size() != 1 {
+		t.Errorf("Size() = %d", q.Size())
+	}
+}
+
+func TestQueue_Close(t *testing.T) {
+	clock := newFakeClock()
+	q := NewWithClock[int](clock)
+	_ = q.EnqueueAfter(1, 0)
+	_ = q.EnqueueAfter(2, time.Hour)
+
+	errs := make(chan error)
+	go func() {
+		// 第一个元素已经到期，第二个元素等待时被关闭
+		if v, err := q.Poll(context.Background()); err != nil || v != 1 {
+			t.Errorf("Poll() = %d, %v", v, err)
+		}
+		_, err := q.Poll(context.Background())
+		errs <- err
+	}()
+	clock.waitTimers(t, 1)
+	q.Close()
+	q.Close()
+	if err := <-errs; !errors.Is(err, ErrClosed) {
+		t.Errorf("Poll() error = %v", err)
+	}
+	// 与阻塞队列共用同一个错误值
+	if err := q.EnqueueAfter(3, 0); !errors.Is(err, blockingqueue.ErrClosed) {
+		t.Errorf("Enqueue() after Close error = %v", err)
+	}
+	// 关闭后已经到期的元素仍然可以出队
+	clock.Advance(time.Hour)
+	if v, ok := q.Dequeue(); !ok || v != 2 {
+		t.Errorf("Dequeue() = %d, %v", v, ok)
+	}
+	if _, ok := q.Dequeue(); ok || !q.IsClosed() {
+		t.Errorf("Dequeue() on closed empty queue")
+	}
+}
+
+func TestQueue_RealClock(t *testing.T) {
+	q := New[int]()
+	start := time.Now()
+	_ = q.EnqueueAfter(2, 20*time.Millisecond)
+	_ = q.EnqueueAfter(1, 10*time.Millisecond)
+	for _, want := range []int{1, 2} {
+		if v, ok := q.Dequeue(); !ok || v != want {
+			t.Fatalf("Dequeue() = %d, %v, want %d", v, ok, want)
+		}
+	}
+	if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+		t.Errorf("Dequeue() returned after %v", elapsed)
+	}
+}
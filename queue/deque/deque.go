@@ -0,0 +1,229 @@
+package deque
+
+import "fmt"
+
+// 最小容量，容量不会收缩到该值以下
+const minCapacity = 16
+
+// Deque 环形缓冲区实现的双端队列，两端的插入删除都是摊还O(1)，按下标访问O(1)
+// 满时容量翻倍，元素个数不足容量的1/4时容量减半
+// 同时实现了DSQueue，Enqueue/Dequeue/Front分别对应PushBack/PopFront/PeekFront
+type Deque[T any] struct {
+	// 容量总是2的幂，下标用位运算取模
+	buf []T
+	// 队头元素的下标
+	head int
+	size int
+}
+
+// New 初始化一个双端队列，values依次从队尾加入
+func New[T any](values ...T) *Deque[T] {
+	var d = &Deque[T]{}
+	for _, v := range values {
+		d.PushBack(v)
+	}
+	return d
+}
+
+// PushFront 从队头加入一个元素
+func (d *Deque[T]) PushFront(v T) {
+	d.growIfFull()
+	d.head = (d.head - 1) & (len(d.buf) - 1)
+	d.buf[d.head] = v
+	d.size++
+}
+
+// PushBack 从队尾加入一个元素
+func (d *Deque[T]) PushBack(v T) {
+	d.growIfFull()
+	d.buf[d.physical(d.size)] = v
+	d.size++
+}
+
+// PopFront 从队头弹出一个元素，如果队列为空则返回一个零值和false
+func (d *Deque[T]) PopFront() (T, bool) {
+	var zeroValue T
+	if d.size == 0 {
+		return zeroValue, false
+	}
+	v := d.buf[d.head]
+	// 清除引用，避免内存泄漏
+	d.buf[d.head] = zeroValue
+	d.head = (d.head + 1) & (len(d.buf) - 1)
+	d.size--
+	d.shrinkIfSparse()
+	return v, true
+}
+
+// PopBack 从队尾弹出一个元素，如果队列为空则返回一个零值和false
+func (d *Deque[T]) PopBack() (T, bool) {
+	var zeroValue T
+	if d.size == 0 {
+		return zeroValue, false
+	}
+	tail := d.physical(d.size - 1)
+	v := d.buf[tail]
+	d.buf[tail] = zeroValue
+	d.size--
+	d.shrinkIfSparse()
+	return v, true
+}
+
+// PeekFront 查看队头的元素，如果队列为空则返回一个零值和false
+func (d *Deque[T]) PeekFront() (T, bool) {
+	if d.size == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return d.buf[d.head], true
+}
+
+// PeekBack 查看队尾的元素，如果队列为空则返回一个零值和false
+func (d *Deque[T]) PeekBack() (T, bool) {
+	if d.size == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return d.buf[d.physical(d.size-1)], true
+}
+
+// Get 通过下标获取元素，下标0为队头
+func (d *Deque[T]) Get(index int) (T, bool) {
+	if index < 0 || index >= d.size {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return d.buf[d.physical(index)], true
+}
+
+// Set 修改下标为index的元素，下标越界时返回false
+func (d *Deque[T]) Set(index int, v T) bool {
+	if index < 0 || index >= d.size {
+		return false
+	}
+	d.buf[d.physical(index)] = v
+	return true
+}
+
+// Rotate 把队列向队尾方向循环移动k步，即队尾的k个元素移动到队头；k为负数时把队头的|k|个元素移动到队尾
+// 时间复杂度O(min(|k|, n-|k|))，队列满时只需移动队头下标
+func (d *Deque[T]) Rotate(k int) {
+	if d.size <= 1 {
+		return
+	}
+	k %= d.size
+	if k < 0 {
+		k += d.size
+	}
+	if k == 0 {
+		return
+	}
+	if d.size == len(d.buf) {
+		d.head = (d.head - k) & (len(d.buf) - 1)
+		return
+	}
+	// 选择移动元素较少的方向，缓冲区未满时不会触发扩缩容
+	if k <= d.size/2 {
+		for i := 0; i < k; i++ {
+			tail := d.physical(d.size - 1)
+			d.head = (d.head - 1) & (len(d.buf) - 1)
+			d.buf[d.head] = d.buf[tail]
+			var zeroValue T
+			d.buf[tail] = zeroValue
+		}
+	} else {
+		for i := 0; i < d.size-k; i++ {
+			next := d.physical(d.size)
+			d.buf[next] = d.buf[d.head]
+			var zeroValue T
+			d.buf[d.head] = zeroValue
+			d.head = (d.head + 1) & (len(d.buf) - 1)
+		}
+	}
+}
+
+// Enqueue 从队尾加入一个元素，同PushBack
+func (d *Deque[T]) Enqueue(v T) {
+	d.PushBack(v)
+}
+
+// Dequeue 从队头弹出一个元素，同PopFront
+func (d *Deque[T]) Dequeue() (T, bool) {
+	return d.PopFront()
+}
+
+// Front 查看队头的元素，同PeekFront
+func (d *Deque[T]) Front() (T, bool) {
+	return d.PeekFront()
+}
+
+// Size 返回元素个数
+func (d *Deque[T]) Size() int {
+	return d.size
+}
+
+// IsEmpty 判断队列是否为空
+func (d *Deque[T]) IsEmpty() bool {
+	return d.size == 0
+}
+
+// Capacity 返回环形缓冲区的容量
+func (d *Deque[T]) Capacity() int {
+	return len(d.buf)
+}
+
+// Clear 清空队列，释放缓冲区
+func (d *Deque[T]) Clear() {
+	d.buf = nil
+	d.head = 0
+	d.size = 0
+}
+
+// Values 从队头到队尾返回所有元素
+func (d *Deque[T]) Values() []T {
+	values := make([]T, d.size)
+	for i := range values {
+		values[i] = d.buf[d.physical(i)]
+	}
+	return values
+}
+
+// Print 从队头到队尾打印队列的元素
+func (d *Deque[T]) Print() {
+	fmt.Println("Deque: ")
+	for i := 0; i < d.size; i++ {
+		fmt.Print(d.buf[d.physical(i)], " ")
+	}
+
+	fmt.Println()
+}
+
+// physical 把逻辑下标转换为缓冲区下标
+func (d *Deque[T]) physical(index int) int {
+	return (d.head + index) & (len(d.buf) - 1)
+}
+
+func (d *Deque[T]) growIfFull() {
+	if d.size == len(d.buf) {
+		d.resize(len(d.buf) * 2)
+	}
+}
+
+func (d *Deque[T]) shrinkIfSparse() {
+	if len(d.buf) > minCapacity && d.size < len(d.buf)/4 {
+		d.resize(len(d.buf) / 2)
+	}
+}
+
+// resize 把元素按顺序搬到容量为capacity的新缓冲区，队头从下标0开始
+func (d *Deque[T]) resize(capacity int) {
+	if capacity < minCapacity {
+		capacity = minCapacity
+	}
+	buf := make([]T, capacity)
+	for i := 0; i < d.size; i++ {
+		buf[i] = d.buf[d.physical(i)]
+	}
+	d.buf = buf
+	d.head = 0
+}
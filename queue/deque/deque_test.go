@@ -0,0 +1,160 @@
+package deque
+
+import (
+	dsqueue "github.com/dairongpeng/ds/queue"
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+var _ dsqueue.DSDeque[int] = (*Deque[int])(nil)
+
+func TestDeque_Random(t *testing.T) {
+	d := New[int]()
+	var model []int
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		switch r.Intn(5) {
+		case 0:
+			d.PushFront(i)
+			model = append([]int{i}, model...)
+		case 1:
+			d.PushBack(i)
+			model = append(model, i)
+		case 2:
+			v, ok := d.PopFront()
+			if len(model) == 0 {
+				if ok {
+					t.Fatalf("PopFront() on empty deque")
+				}
+				continue
+			}
+			if !ok || v != model[0] {
+				t.Fatalf("PopFront() = %d, %v, want %d", v, ok, model[0])
+			}
+			model = model[1:]
+		case 3:
+			v, ok := d.PopBack()
+			if len(model) == 0 {
+				if ok {
+					t.Fatalf("PopBack() on empty deque")
+				}
+				continue
+			}
+			if !ok || v != model[len(model)-1] {
+				t.Fatalf("PopBack() = %d, %v, want %d", v, ok, model[len(model)-1])
+			}
+			model = model[:len(model)-1]
+		case 4:
+			k := r.Intn(7) - 3
+			d.Rotate(k)
+			model = rotate(model, k)
+		}
+		if d.Size() != len(model) {
+			t.Fatalf("Size() = %d, want %d", d.Size(), len(model))
+		}
+		if i%500 == 0 && !reflect.DeepEqual(d.Values(), model) {
+			t.Fatalf("Values() = %v, want %v", d.Values(), model)
+		}
+	}
+}
+
+// rotate 参照实现，队尾的k个元素移动到队头
+func rotate(s []int, k int) []int {
+	n := len(s)
+	if n == 0 {
+		return s
+	}
+	k = ((k % n) + n) % n
+	return append(append([]int{}, s[n-k:]...), s[:n-k]...)
+}
+
+func TestDeque_Rotate(t *testing.T) {
+	type testCase struct {
+		name string
+		// full为true时缓冲区恰好填满
+		full bool
+		k    int
+	}
+	tests := []testCase{
+		{name: "right", k: 2},
+		{name: "left", k: -3},
+		{name: "right_far", k: 13},
+		{name: "full_right", full: true, k: 5},
+		{name: "full_left", full: true, k: -20},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := 10
+			if tt.full {
+				n = minCapacity
+			}
+			d := New[int]()
+			var model []int
+			for i := 0; i < n; i++ {
+				// 让队头不在下标0
+				d.PushFront(i)
+				model = append([]int{i}, model...)
+			}
+			d.Rotate(tt.k)
+			if want := rotate(model, tt.k); !reflect.DeepEqual(d.Values(), want) {
+				t.Errorf("Rotate(%d) = %v, want %v", tt.k, d.Values(), want)
+			}
+		})
+	}
+}
+
+func TestDeque_Access(t *testing.T) {
+	d := New[string]("b", "c")
+	d.PushFront("a")
+	if v, _ := d.PeekFront(); v != "a" {
+		t.Errorf("PeekFront() = %s", v)
+	}
+	if v, _ := d.PeekBack(); v != "c" {
+		t.Errorf("PeekBack() = %s", v)
+	}
+	if !d.Set(1, "x") || d.Set(3, "y") {
+		t.Errorf("Set() mismatch")
+	}
+	if v, ok := d.Get(1); !ok || v != "x" {
+		t.Errorf("Get(1) = %s, %v", v, ok)
+	}
+	if _, ok := d.Get(-1); ok {
+		t.Errorf("Get(-1) out of range")
+	}
+	d.Clear()
+	if _, ok := d.PeekBack(); ok || !d.IsEmpty() {
+		t.Errorf("Clear() left elements")
+	}
+}
+
+// 单调队列求滑动窗口最大值，双端队列中保存下标，对应的值从队头到队尾递减
+func TestDeque_SlidingWindowMax(t *testing.T) {
+	arr := []int{4, 3, 5, 4, 3, 3, 6, 7}
+	w := 3
+	want := []int{5, 5, 5, 4, 6, 7}
+
+	var got []int
+	d := New[int]()
+	for i, v := range arr {
+		for !d.IsEmpty() {
+			back, _ := d.PeekBack()
+			if arr[back] > v {
+				break
+			}
+			d.PopBack()
+		}
+		d.PushBack(i)
+		// 队头下标过期
+		if front, _ := d.PeekFront(); front <= i-w {
+			d.PopFront()
+		}
+		if i >= w-1 {
+			front, _ := d.PeekFront()
+			got = append(got, arr[front])
+		}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("sliding window max = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,51 @@
+package notify
+
+import (
+	"errors"
+	"sync"
+)
+
+// ErrClosed 队列已关闭，各个可关闭的队列共用这一个错误值
+var ErrClosed = errors.New("queue is closed")
+
+// Broadcast 唤醒在ch上等待的所有goroutine，返回nil表示当前没有等待者
+// 使用方在加锁的情况下按需创建ch，等待者在释放锁之后等待ch被关闭
+func Broadcast(ch chan struct{}) chan struct{} {
+	if ch != nil {
+		close(ch)
+	}
+	return nil
+}
+
+// Closer 关闭信号，并发安全。关闭时关闭Done返回的channel，唤醒所有等待者
+type Closer struct {
+	once sync.Once
+	done chan struct{}
+}
+
+// NewCloser 初始化一个未关闭的Closer
+func NewCloser() *Closer {
+	return &Closer{done: make(chan struct{})}
+}
+
+// Close 关闭信号，重复关闭无副作用
+func (c *Closer) Close() {
+	c.once.Do(func() {
+		close(c.done)
+	})
+}
+
+// IsClosed 判断是否已经关闭
+func (c *Closer) IsClosed() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// Done 返回关闭时被关闭的channel
+func (c *Closer) Done() <-chan struct{} {
+	return c.done
+}
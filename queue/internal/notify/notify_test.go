@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestBroadcast(t *testing.T) {
+	if Broadcast(nil) != nil {
+		t.Fatalf("Broadcast(nil) should return nil")
+	}
+	ch := make(chan struct{})
+	var wg sync.WaitGroup
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-ch
+		}()
+	}
+	if Broadcast(ch) != nil {
+		t.Errorf("Broadcast() should reset the channel")
+	}
+	// 所有等待者都被唤醒
+	wg.Wait()
+}
+
+func TestCloser(t *testing.T) {
+	c := NewCloser()
+	if c.IsClosed() {
+		t.Fatalf("new Closer is closed")
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			c.Close()
+		}()
+	}
+	wg.Wait()
+	if !c.IsClosed() {
+		t.Errorf("IsClosed() = false after Close")
+	}
+	<-c.Done()
+}
@@ -0,0 +1,80 @@
+package linkedlistqueue
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/list/linkedlist"
+)
+
+// Queue 单链表实现的队列，维护头尾两个指针，从尾部入队、从头部出队，都是O(1)且不会重新分配内存
+type Queue[T any] struct {
+	head *linkedlist.Node[T]
+	tail *linkedlist.Node[T]
+	size int
+}
+
+// New 初始化一个队列
+func New[T any](values ...T) *Queue[T] {
+	var q = &Queue[T]{}
+	for _, v := range values {
+		q.Enqueue(v)
+	}
+	return q
+}
+
+// Enqueue 从队尾加入一个元素
+func (q *Queue[T]) Enqueue(v T) {
+	node := &linkedlist.Node[T]{Value: v}
+	if q.tail == nil {
+		q.head = node
+	} else {
+		q.tail.Next = node
+	}
+	q.tail = node
+	q.size++
+}
+
+// Dequeue 从队头弹出一个元素，如果队列为空则返回一个零值和false
+func (q *Queue[T]) Dequeue() (T, bool) {
+	if q.head == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	node := q.head
+	q.head = node.Next
+	if q.head == nil {
+		q.tail = nil
+	}
+	// 断开出队节点，便于回收
+	node.Next = nil
+	q.size--
+	return node.Value, true
+}
+
+// Front 查看队头的元素，不出队。如果队列为空则返回一个零值和false
+func (q *Queue[T]) Front() (T, bool) {
+	if q.head == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return q.head.Value, true
+}
+
+// Size 返回队列的元素个数
+func (q *Queue[T]) Size() int {
+	return q.size
+}
+
+// IsEmpty 判断队列是否为空
+func (q *Queue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// Print 从队头到队尾打印队列的元素
+func (q *Queue[T]) Print() {
+	fmt.Println("Linked List Queue: ")
+	for cur := q.head; cur != nil; cur = cur.Next {
+		fmt.Print(cur.Value, " ")
+	}
+
+	fmt.Println()
+}
@@ -0,0 +1,104 @@
+package lockfree
+
+import (
+	"sync/atomic"
+)
+
+// node 链表节点，发布后value不再修改
+type node[T any] struct {
+	value T
+	next  atomic.Pointer[node[T]]
+}
+
+// Queue Michael-Scott无锁队列，支持多生产者多消费者(MPMC)，无界
+// head始终指向一个哨兵节点，队头元素是head.next。入队和出队都只通过CAS推进指针，
+// 竞争失败的一方会帮助推进落后的tail，保证整体总能向前推进。节点由GC回收，不存在ABA问题
+// 出队后的节点成为新的哨兵，它持有的元素要等到下一次出队后才能被回收
+type Queue[T any] struct {
+	head atomic.Pointer[node[T]]
+	tail atomic.Pointer[node[T]]
+	size atomic.Int64
+}
+
+// New 初始化一个无锁队列
+func New[T any]() *Queue[T] {
+	q := &Queue[T]{}
+	dummy := &node[T]{}
+	q.head.Store(dummy)
+	q.tail.Store(dummy)
+	return q
+}
+
+// Enqueue 从队尾加入一个元素
+func (q *Queue[T]) Enqueue(v T) {
+	n := &node[T]{value: v}
+	for {
+		tail := q.tail.Load()
+		next := tail.next.Load()
+		if tail != q.tail.Load() {
+			continue
+		}
+		if next != nil {
+			// tail落后了，帮助推进后重试
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		if tail.next.CompareAndSwap(nil, n) {
+			// 推进tail失败说明其他goroutine已经帮忙推进
+			q.tail.CompareAndSwap(tail, n)
+			q.size.Add(1)
+			return
+		}
+	}
+}
+
+// Dequeue 从队头弹出一个元素，如果队列为空则返回一个零值和false，不会阻塞
+func (q *Queue[T]) Dequeue() (T, bool) {
+	for {
+		head := q.head.Load()
+		tail := q.tail.Load()
+		next := head.next.Load()
+		if head != q.head.Load() {
+			continue
+		}
+		if next == nil {
+			var zeroValue T
+			return zeroValue, false
+		}
+		if head == tail {
+			// 有元素但tail落后，帮助推进
+			q.tail.CompareAndSwap(tail, next)
+			continue
+		}
+		v := next.value
+		if q.head.CompareAndSwap(head, next) {
+			q.size.Add(-1)
+			return v, true
+		}
+	}
+}
+
+// Front 查看队头的元素，不出队。如果队列为空则返回一个零值和false
+// 并发场景下返回的元素可能已经被其他消费者取走
+func (q *Queue[T]) Front() (T, bool) {
+	next := q.head.Load().next.Load()
+	if next == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return next.value, true
+}
+
+// Size 返回队列的元素个数，并发修改时只是一个近似值
+func (q *Queue[T]) Size() int {
+	// 出队的计数可能先于入队的计数生效，短暂出现负数
+	if n := q.size.Load(); n > 0 {
+		return int(n)
+	}
+	return 0
+}
+
+// IsEmpty 判断队列是否为空
+func (q *Queue[T]) IsEmpty() bool {
+	return q.head.Load().next.Load() == nil
+}
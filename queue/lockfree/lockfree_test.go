@@ -0,0 +1,233 @@
+package lockfree
+
+import (
+	"github.com/dairongpeng/ds/queue/arrayqueue"
+	"runtime"
+	"sync"
+	"testing"
+)
+
+// queue DSQueue中除Print之外的方法，两种无锁队列都实现了该接口
+type queue[T any] interface {
+	Enqueue(value T)
+	Dequeue() (T, bool)
+	Front() (T, bool)
+	Size() int
+	IsEmpty() bool
+}
+
+var (
+	_ queue[int] = (*Queue[int])(nil)
+	_ queue[int] = (*SPSCQueue[int])(nil)
+)
+
+func TestQueue_Sequential(t *testing.T) {
+	type testCase struct {
+		name string
+		q    queue[int]
+	}
+	tests := []testCase{
+		{name: "mpmc", q: New[int]()},
+		{name: "spsc", q: NewSPSC[int](128)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := tt.q
+			if _, ok := q.Dequeue(); ok || !q.IsEmpty() {
+				t.Fatalf("new queue should be empty")
+			}
+			for i := 0; i < 100; i++ {
+				q.Enqueue(i)
+			}
+			if v, ok := q.Front(); !ok || v != 0 || q.Size() != 100 {
+				t.Fatalf("Front() = %d, %v, Size() = %d", v, ok, q.Size())
+			}
+			for i := 0; i < 100; i++ {
+				if v, ok := q.Dequeue(); !ok || v != i {
+					t.Fatalf("Dequeue() = %d, %v, want %d", v, ok, i)
+				}
+			}
+			if _, ok := q.Front(); ok || !q.IsEmpty() || q.Size() != 0 {
+				t.Errorf("queue should be empty")
+			}
+		})
+	}
+}
+
+func TestSPSCQueue_Full(t *testing.T) {
+	q := NewSPSC[int](3)
+	if q.Capacity() != 4 {
+		t.Errorf("Capacity() = %d, want 4", q.Capacity())
+	}
+	for i := 0; i < 4; i++ {
+		if !q.Offer(i) {
+			t.Fatalf("Offer(%d) failed", i)
+		}
+	}
+	if q.Offer(4) {
+		t.Errorf("Offer() on full queue")
+	}
+	q.Dequeue()
+	if !q.Offer(4) {
+		t.Errorf("Offer() after Dequeue() failed")
+	}
+}
+
+// 多生产者多消费者压测，配合-race运行。每个元素恰好被取出一次，同一生产者的元素保持入队顺序
+func TestQueue_MPMCStress(t *testing.T) {
+	const producers, consumers, perProducer = 8, 8, 2000
+	q := New[int]()
+
+	var producerWg, consumerWg sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producerWg.Add(1)
+		go func(p int) {
+			defer producerWg.Done()
+			for i := 0; i < perProducer; i++ {
+				q.Enqueue(p*perProducer + i)
+			}
+		}(p)
+	}
+
+	done := make(chan struct{})
+	seen := make([][]int, consumers)
+	for c := 0; c < consumers; c++ {
+		consumerWg.Add(1)
+		go func(c int) {
+			defer consumerWg.Done()
+			for {
+				if v, ok := q.Dequeue(); ok {
+					seen[c] = append(seen[c], v)
+					continue
+				}
+				select {
+				case <-done:
+					// 生产者已经结束，取完剩余元素后退出
+					if q.IsEmpty() {
+						return
+					}
+				default:
+					runtime.Gosched()
+				}
+			}
+		}(c)
+	}
+	producerWg.Wait()
+	close(done)
+	consumerWg.Wait()
+
+	count := make([]int, producers*perProducer)
+	for _, values := range seen {
+		last := make(map[int]int)
+		for _, v := range values {
+			count[v]++
+			p := v / perProducer
+			if prev, ok := last[p]; ok && prev > v {
+				t.Fatalf("producer %d out of order: %d after %d", p, v, prev)
+			}
+			last[p] = v
+		}
+	}
+	for v, c := range count {
+		if c != 1 {
+			t.Fatalf("value %d dequeued %d times", v, c)
+		}
+	}
+	if q.Size() != 0 {
+		t.Errorf("Size() = %d after draining", q.Size())
+	}
+}
+
+func TestSPSCQueue_Stress(t *testing.T) {
+	const n = 50000
+	q := NewSPSC[int](64)
+	go func() {
+		for i := 0; i < n; i++ {
+			q.Enqueue(i)
+		}
+	}()
+	for i := 0; i < n; {
+		v, ok := q.Dequeue()
+		if !ok {
+			runtime.Gosched()
+			continue
+		}
+		if v != i {
+			t.Fatalf("Dequeue() = %d, want %d", v, i)
+		}
+		i++
+	}
+}
+
+// mutexQueue 互斥锁保护的arrayqueue，作为基准测试的对照组
+type mutexQueue[T any] struct {
+	mu sync.Mutex
+	q  *arrayqueue.Queue[T]
+}
+
+func (m *mutexQueue[T]) Enqueue(v T) {
+	m.mu.Lock()
+	m.q.Enqueue(v)
+	m.mu.Unlock()
+}
+
+func (m *mutexQueue[T]) Dequeue() (T, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.q.Dequeue()
+}
+
+func benchmarkParallel(b *testing.B, q interface {
+	Enqueue(int)
+	Dequeue() (int, bool)
+}) {
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			q.Enqueue(i)
+			q.Dequeue()
+			i++
+		}
+	})
+}
+
+func BenchmarkQueue_MPMC(b *testing.B) {
+	benchmarkParallel(b, New[int]())
+}
+
+func BenchmarkQueue_Mutex(b *testing.B) {
+	benchmarkParallel(b, &mutexQueue[int]{q: arrayqueue.New[int]()})
+}
+
+func benchmarkPipeline(b *testing.B, enqueue func(int), dequeue func() (int, bool)) {
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < b.N; {
+			if _, ok := dequeue(); ok {
+				i++
+			} else {
+				runtime.Gosched()
+			}
+		}
+		close(done)
+	}()
+	for i := 0; i < b.N; i++ {
+		enqueue(i)
+	}
+	<-done
+}
+
+func BenchmarkPipeline_SPSC(b *testing.B) {
+	q := NewSPSC[int](1024)
+	benchmarkPipeline(b, q.Enqueue, q.Dequeue)
+}
+
+func BenchmarkPipeline_MPMC(b *testing.B) {
+	q := New[int]()
+	benchmarkPipeline(b, q.Enqueue, q.Dequeue)
+}
+
+func BenchmarkPipeline_Mutex(b *testing.B) {
+	q := &mutexQueue[int]{q: arrayqueue.New[int]()}
+	benchmarkPipeline(b, q.Enqueue, q.Dequeue)
+}
@@ -0,0 +1,94 @@
+package lockfree
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// cacheLinePad 填充到一个缓存行，避免生产者和消费者的下标位于同一缓存行产生伪共享
+type cacheLinePad [64]byte
+
+// SPSCQueue 单生产者单消费者(SPSC)的有界无锁环形队列
+// 只允许一个goroutine入队、一个goroutine出队。生产者只写tail，消费者只写head，
+// 双方通过原子读取对方的下标判断满和空，不需要CAS
+type SPSCQueue[T any] struct {
+	_    cacheLinePad
+	head atomic.Uint64
+	_    cacheLinePad
+	tail atomic.Uint64
+	_    cacheLinePad
+	// 容量总是2的幂，下标用位运算取模
+	buf  []T
+	mask uint64
+}
+
+// NewSPSC 初始化一个SPSC队列，容量向上取整为2的幂，最小为2
+func NewSPSC[T any](capacity int) *SPSCQueue[T] {
+	c := 2
+	for c < capacity {
+		c <<= 1
+	}
+	return &SPSCQueue[T]{
+		buf:  make([]T, c),
+		mask: uint64(c - 1),
+	}
+}
+
+// Offer 从队尾加入一个元素，队列满时返回false。只能由生产者调用
+func (q *SPSCQueue[T]) Offer(v T) bool {
+	tail := q.tail.Load()
+	if tail-q.head.Load() == uint64(len(q.buf)) {
+		return false
+	}
+	q.buf[tail&q.mask] = v
+	// 写入元素之后再发布tail，消费者看到新的tail时一定能看到元素
+	q.tail.Store(tail + 1)
+	return true
+}
+
+// Enqueue 从队尾加入一个元素，队列满时让出处理器并重试。只能由生产者调用
+func (q *SPSCQueue[T]) Enqueue(v T) {
+	for !q.Offer(v) {
+		runtime.Gosched()
+	}
+}
+
+// Dequeue 从队头弹出一个元素，如果队列为空则返回一个零值和false，不会阻塞。只能由消费者调用
+func (q *SPSCQueue[T]) Dequeue() (T, bool) {
+	var zeroValue T
+	head := q.head.Load()
+	if head == q.tail.Load() {
+		return zeroValue, false
+	}
+	v := q.buf[head&q.mask]
+	// 清除引用，避免内存泄漏
+	q.buf[head&q.mask] = zeroValue
+	q.head.Store(head + 1)
+	return v, true
+}
+
+// Front 查看队头的元素，不出队。如果队列为空则返回一个零值和false。只能由消费者调用
+func (q *SPSCQueue[T]) Front() (T, bool) {
+	head := q.head.Load()
+	if head == q.tail.Load() {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return q.buf[head&q.mask], true
+}
+
+// Size 返回队列的元素个数，并发修改时只是一个近似值
+func (q *SPSCQueue[T]) Size() int {
+	head := q.head.Load()
+	return int(q.tail.Load() - head)
+}
+
+// IsEmpty 判断队列是否为空
+func (q *SPSCQueue[T]) IsEmpty() bool {
+	return q.Size() == 0
+}
+
+// Capacity 返回队列的容量
+func (q *SPSCQueue[T]) Capacity() int {
+	return len(q.buf)
+}
@@ -0,0 +1,90 @@
+package priorityqueue
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/heap/minheap"
+	"github.com/dairongpeng/ds/pkg"
+)
+
+// item 堆中的元素，seq为入队序号，优先级相同时序号小的先出队
+type item[T any] struct {
+	value T
+	seq   uint64
+}
+
+// Queue 基于小根堆的优先级队列，容量不受限制
+// comparator比较结果较小的元素优先出队，优先级相同的元素按入队顺序出队(FIFO)
+// 需要大的元素先出队时，传入反向的comparator即可
+type Queue[T any] struct {
+	heap *minheap.MinHeap[item[T]]
+	// 下一个入队元素的序号
+	seq uint64
+}
+
+// New 初始化一个优先级队列，values依次入队
+func New[T any](comparator pkg.Comparator[T], values ...T) *Queue[T] {
+	q := &Queue[T]{
+		heap: minheap.NewMaxHeap[item[T]](0, func(a, b item[T]) int {
+			if c := comparator(a.value, b.value); c != 0 {
+				return c
+			}
+			if a.seq < b.seq {
+				return -1
+			}
+			if a.seq > b.seq {
+				return 1
+			}
+			return 0
+		}),
+	}
+	for _, v := range values {
+		q.Enqueue(v)
+	}
+	return q
+}
+
+// Enqueue 加入一个元素，O(logN)
+func (q *Queue[T]) Enqueue(v T) {
+	// 堆不限制容量，不会返回错误
+	_ = q.heap.Push(item[T]{value: v, seq: q.seq})
+	q.seq++
+}
+
+// Dequeue 弹出优先级最高的元素，O(logN)。如果队列为空则返回一个零值和false
+func (q *Queue[T]) Dequeue() (T, bool) {
+	it, ok := q.heap.TryPop()
+	return it.value, ok
+}
+
+// Front 查看优先级最高的元素，不出队。如果队列为空则返回一个零值和false
+func (q *Queue[T]) Front() (T, bool) {
+	it, ok := q.heap.Peek()
+	return it.value, ok
+}
+
+// Size 返回队列的元素个数
+func (q *Queue[T]) Size() int {
+	return q.heap.Size()
+}
+
+// IsEmpty 判断队列是否为空
+func (q *Queue[T]) IsEmpty() bool {
+	return q.heap.IsEmpty()
+}
+
+// Print 按出队顺序打印队列的元素，O(N*logN)
+func (q *Queue[T]) Print() {
+	fmt.Println("Priority Queue: ")
+	items := make([]item[T], 0, q.heap.Size())
+	for !q.heap.IsEmpty() {
+		it := q.heap.Pop()
+		items = append(items, it)
+		fmt.Print(it.value, " ")
+	}
+	// 放回堆中，序号不变，出队顺序也不变
+	for _, it := range items {
+		_ = q.heap.Push(it)
+	}
+
+	fmt.Println()
+}
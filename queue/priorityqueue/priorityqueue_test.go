@@ -0,0 +1,78 @@
+package priorityqueue
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"math/rand"
+	"sort"
+	"strconv"
+	"testing"
+)
+
+// job 优先级相同时用name校验出队顺序
+type job struct {
+	priority int
+	name     string
+}
+
+func jobComparator(a, b job) int {
+	return a.priority - b.priority
+}
+
+func TestQueue_Stable(t *testing.T) {
+	q := New[job](jobComparator)
+	jobs := []job{
+		{priority: 2, name: "a"},
+		{priority: 1, name: "b"},
+		{priority: 2, name: "c"},
+		{priority: 0, name: "d"},
+		{priority: 1, name: "e"},
+		{priority: 2, name: "f"},
+	}
+	for _, j := range jobs {
+		q.Enqueue(j)
+	}
+	want := []string{"d", "b", "e", "a", "c", "f"}
+	if v, ok := q.Front(); !ok || v.name != "d" {
+		t.Errorf("Front() = %v, %v", v, ok)
+	}
+	for i, name := range want {
+		v, ok := q.Dequeue()
+		if !ok || v.name != name {
+			t.Fatalf("Dequeue() #%d = %v, %v, want %s", i, v, ok, name)
+		}
+	}
+	if _, ok := q.Dequeue(); ok || !q.IsEmpty() {
+		t.Errorf("queue should be empty")
+	}
+}
+
+func TestQueue_Random(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	q := New[job](jobComparator)
+	var model []job
+	for i := 0; i < 5000; i++ {
+		// name取入队序号，保证唯一，相同优先级的出队顺序可以被准确校验
+		j := job{priority: r.Intn(20), name: strconv.Itoa(i)}
+		q.Enqueue(j)
+		model = append(model, j)
+	}
+	// 稳定排序的结果就是期望的出队顺序
+	sort.SliceStable(model, func(i, j int) bool {
+		return model[i].priority < model[j].priority
+	})
+	for i, want := range model {
+		if v, _ := q.Dequeue(); v != want {
+			t.Fatalf("Dequeue() #%d = %v, want %v", i, v, want)
+		}
+	}
+}
+
+func TestQueue_Reverse(t *testing.T) {
+	// 反向的comparator实现大的先出队
+	q := New[int](func(a, b int) int { return pkg.NumberComparator(b, a) }, 3, 1, 4, 1, 5)
+	for _, want := range []int{5, 4, 3, 1, 1} {
+		if v, _ := q.Dequeue(); v != want {
+			t.Fatalf("Dequeue() = %d, want %d", v, want)
+		}
+	}
+}
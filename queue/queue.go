@@ -8,3 +8,18 @@ type DSQueue[T any] interface {
 	IsEmpty() bool
 	Print()
 }
+
+// DSDeque 双端队列，两端都可以插入和删除元素，下标0为队头
+type DSDeque[T any] interface {
+	PushFront(value T)
+	PushBack(value T)
+	PopFront() (T, bool)
+	PopBack() (T, bool)
+	PeekFront() (T, bool)
+	PeekBack() (T, bool)
+	Get(index int) (T, bool)
+	Set(index int, value T) bool
+	Size() int
+	IsEmpty() bool
+	Print()
+}
@@ -0,0 +1,106 @@
+package dsqueue_test
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	dsqueue "github.com/dairongpeng/ds/queue"
+	"github.com/dairongpeng/ds/queue/arrayqueue"
+	"github.com/dairongpeng/ds/queue/deque"
+	"github.com/dairongpeng/ds/queue/linkedlistqueue"
+	"github.com/dairongpeng/ds/queue/priorityqueue"
+	"github.com/dairongpeng/ds/queue/ringqueue"
+	"math/rand"
+	"testing"
+)
+
+// queueImpls 参与一致性测试的DSQueue实现，新增实现时在这里注册
+// 阻塞队列在队列为空时Dequeue会阻塞而不是返回false，不参与该测试
+var queueImpls = []struct {
+	name     string
+	newQueue func() dsqueue.DSQueue[int]
+}{
+	{name: "arrayqueue", newQueue: func() dsqueue.DSQueue[int] { return arrayqueue.New[int]() }},
+	{name: "linkedlistqueue", newQueue: func() dsqueue.DSQueue[int] { return linkedlistqueue.New[int]() }},
+	{name: "ringqueue", newQueue: func() dsqueue.DSQueue[int] { return ringqueue.New[int]() }},
+	{name: "deque", newQueue: func() dsqueue.DSQueue[int] { return deque.New[int]() }},
+	// 测试中入队的元素递增，优先级顺序与入队顺序一致
+	{name: "priorityqueue", newQueue: func() dsqueue.DSQueue[int] { return priorityqueue.New[int](pkg.NumberComparator[int]) }},
+}
+
+func TestDSQueue_Empty(t *testing.T) {
+	for _, impl := range queueImpls {
+		t.Run(impl.name, func(t *testing.T) {
+			q := impl.newQueue()
+			if !q.IsEmpty() || q.Size() != 0 {
+				t.Errorf("new queue should be empty")
+			}
+			if _, ok := q.Dequeue(); ok {
+				t.Errorf("Dequeue() on empty queue")
+			}
+			if _, ok := q.Front(); ok {
+				t.Errorf("Front() on empty queue")
+			}
+		})
+	}
+}
+
+func TestDSQueue_FIFO(t *testing.T) {
+	for _, impl := range queueImpls {
+		t.Run(impl.name, func(t *testing.T) {
+			q := impl.newQueue()
+			for i := 0; i < 100; i++ {
+				q.Enqueue(i)
+				if v, ok := q.Front(); !ok || v != 0 {
+					t.Fatalf("Front() = %d, %v, want 0", v, ok)
+				}
+			}
+			for i := 0; i < 100; i++ {
+				if v, ok := q.Dequeue(); !ok || v != i {
+					t.Fatalf("Dequeue() = %d, %v, want %d", v, ok, i)
+				}
+				if q.Size() != 99-i {
+					t.Fatalf("Size() = %d, want %d", q.Size(), 99-i)
+				}
+			}
+			if !q.IsEmpty() {
+				t.Errorf("queue should be empty")
+			}
+			// 清空后可以继续使用
+			q.Enqueue(7)
+			if v, ok := q.Front(); !ok || v != 7 {
+				t.Errorf("Front() after reuse = %d, %v", v, ok)
+			}
+		})
+	}
+}
+
+func TestDSQueue_Random(t *testing.T) {
+	for _, impl := range queueImpls {
+		t.Run(impl.name, func(t *testing.T) {
+			q := impl.newQueue()
+			var model []int
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 10000; i++ {
+				if r.Intn(3) > 0 {
+					q.Enqueue(i)
+					model = append(model, i)
+					continue
+				}
+				v, ok := q.Dequeue()
+				if len(model) == 0 {
+					if ok {
+						t.Fatalf("Dequeue() on empty queue = %d", v)
+					}
+					continue
+				}
+				want := model[0]
+				model = model[1:]
+				if !ok || v != want {
+					t.Fatalf("Dequeue() = %d, %v, want %d", v, ok, want)
+				}
+				if q.Size() != len(model) {
+					t.Fatalf("Size() = %d, want %d", q.Size(), len(model))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package ringqueue
+
+import "fmt"
+
+// 最小容量，容量不会收缩到该值以下
+const minCapacity = 16
+
+// Queue 环形缓冲区实现的队列，出队后的槽位会被复用
+// 队列满时容量翻倍，元素个数不足容量的1/4时容量减半，长期使用的队列占用的内存与当前元素个数成正比
+type Queue[T any] struct {
+	// 容量总是2的幂，下标用位运算取模
+	buf []T
+	// 队头元素的下标
+	head int
+	size int
+}
+
+// New 初始化一个队列
+func New[T any](values ...T) *Queue[T] {
+	var q = &Queue[T]{}
+	for _, v := range values {
+		q.Enqueue(v)
+	}
+	return q
+}
+
+// Enqueue 从队尾加入一个元素，摊还O(1)
+func (q *Queue[T]) Enqueue(v T) {
+	if q.size == len(q.buf) {
+		q.resize(len(q.buf) * 2)
+	}
+	q.buf[(q.head+q.size)&(len(q.buf)-1)] = v
+	q.size++
+}
+
+// Dequeue 从队头弹出一个元素，如果队列为空则返回一个零值和false
+func (q *Queue[T]) Dequeue() (T, bool) {
+	var zeroValue T
+	if q.size == 0 {
+		return zeroValue, false
+	}
+	v := q.buf[q.head]
+	// 清除引用，避免内存泄漏
+	q.buf[q.head] = zeroValue
+	q.head = (q.head + 1) & (len(q.buf) - 1)
+	q.size--
+	if len(q.buf) > minCapacity && q.size < len(q.buf)/4 {
+		q.resize(len(q.buf) / 2)
+	}
+	return v, true
+}
+
+// Front 查看队头的元素，不出队。如果队列为空则返回一个零值和false
+func (q *Queue[T]) Front() (T, bool) {
+	if q.size == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return q.buf[q.head], true
+}
+
+// Size 返回队列的元素个数
+func (q *Queue[T]) Size() int {
+	return q.size
+}
+
+// IsEmpty 判断队列是否为空
+func (q *Queue[T]) IsEmpty() bool {
+	return q.size == 0
+}
+
+// Capacity 返回环形缓冲区的容量
+func (q *Queue[T]) Capacity() int {
+	return len(q.buf)
+}
+
+// Clear 清空队列，释放缓冲区
+func (q *Queue[T]) Clear() {
+	q.buf = nil
+	q.head = 0
+	q.size = 0
+}
+
+// Print 从队头到队尾打印队列的元素
+func (q *Queue[T]) Print() {
+	fmt.Println("Ring Queue: ")
+	for i := 0; i < q.size; i++ {
+		fmt.Print(q.buf[(q.head+i)&(len(q.buf)-1)], " ")
+	}
+
+	fmt.Println()
+}
+
+// resize 把元素按顺序搬到容量为capacity的新缓冲区，队头从下标0开始
+func (q *Queue[T]) resize(capacity int) {
+	if capacity < minCapacity {
+		capacity = minCapacity
+	}
+	buf := make([]T, capacity)
+	if q.size > 0 {
+		// 元素可能绕过缓冲区末尾，分两段拷贝
+		n := copy(buf, q.buf[q.head:minInt(q.head+q.size, len(q.buf))])
+		copy(buf[n:], q.buf[:q.size-n])
+	}
+	q.buf = buf
+	q.head = 0
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
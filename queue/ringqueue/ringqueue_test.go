@@ -0,0 +1,63 @@
+package ringqueue
+
+import (
+	"testing"
+)
+
+func TestQueue_Wrap(t *testing.T) {
+	q := New[int]()
+	// 出队入队交替进行，队头绕过缓冲区末尾，容量保持不变
+	next := 0
+	for i := 0; i < 10; i++ {
+		q.Enqueue(i)
+	}
+	for i := 10; i < 1000; i++ {
+		q.Enqueue(i)
+		if v, ok := q.Dequeue(); !ok || v != next {
+			t.Fatalf("Dequeue() = %d, %v, want %d", v, ok, next)
+		}
+		next++
+	}
+	if q.Capacity() != minCapacity {
+		t.Errorf("Capacity() = %d, want %d", q.Capacity(), minCapacity)
+	}
+	// 队头不在下标0时扩容，元素顺序保持不变
+	for i := 1000; i < 1100; i++ {
+		q.Enqueue(i)
+	}
+	for q.Size() > 0 {
+		if v, _ := q.Dequeue(); v != next {
+			t.Fatalf("Dequeue() = %d, want %d", v, next)
+		}
+		next++
+	}
+}
+
+func TestQueue_Shrink(t *testing.T) {
+	q := New[*int]()
+	for i := 0; i < 10000; i++ {
+		v := i
+		q.Enqueue(&v)
+	}
+	grown := q.Capacity()
+	for i := 0; i < 9990; i++ {
+		q.Dequeue()
+	}
+	if q.Capacity() >= grown/64 {
+		t.Errorf("Capacity() = %d after shrinking from %d", q.Capacity(), grown)
+	}
+	// 出队的槽位不再持有引用
+	for i := 0; i < q.Capacity(); i++ {
+		inQueue := (i-q.head+len(q.buf))&(len(q.buf)-1) < q.size
+		if !inQueue && q.buf[i] != nil {
+			t.Fatalf("slot %d still holds a reference", i)
+		}
+	}
+	if v, _ := q.Front(); *v != 9990 {
+		t.Errorf("Front() = %d", *v)
+	}
+	q.Clear()
+	if !q.IsEmpty() || q.Capacity() != 0 {
+		t.Errorf("Clear() left elements")
+	}
+}
@@ -0,0 +1,246 @@
+package bitset
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+)
+
+// 每个字的位数
+const wordSize = 64
+
+// BitSet 位图，用一个bit表示一个非负整数是否存在
+// 底层用[]uint64存储，第i位位于words[i/64]的第i%64位。集合运算按字进行，一次处理64位
+// 设置超出长度的位时自动扩容，超出长度的位视为0
+type BitSet struct {
+	words []uint64
+	// 逻辑长度（位数），不小于任何一个被设置过的位的下标加一
+	length uint
+}
+
+// New 初始化一个长度为length的位图，所有位为0
+func New(length uint) *BitSet {
+	return &BitSet{
+		words:  make([]uint64, wordsNeeded(length)),
+		length: length,
+	}
+}
+
+// Len 返回位图的长度（位数）
+func (b *BitSet) Len() uint {
+	return b.length
+}
+
+// Set 把第i位置为1，超出长度时自动扩容
+func (b *BitSet) Set(i uint) *BitSet {
+	b.grow(i + 1)
+	b.words[i/wordSize] |= 1 << (i % wordSize)
+	return b
+}
+
+// Clear 把第i位置为0
+func (b *BitSet) Clear(i uint) *BitSet {
+	if i < b.length {
+		b.words[i/wordSize] &^= 1 << (i % wordSize)
+	}
+	return b
+}
+
+// Test 判断第i位是否为1
+func (b *BitSet) Test(i uint) bool {
+	if i >= b.length {
+		return false
+	}
+	return b.words[i/wordSize]&(1<<(i%wordSize)) != 0
+}
+
+// Flip 翻转第i位，超出长度时自动扩容
+func (b *BitSet) Flip(i uint) *BitSet {
+	b.grow(i + 1)
+	b.words[i/wordSize] ^= 1 << (i % wordSize)
+	return b
+}
+
+// ClearAll 把所有位置为0，长度不变
+func (b *BitSet) ClearAll() *BitSet {
+	for i := range b.words {
+		b.words[i] = 0
+	}
+	return b
+}
+
+// Count 返回为1的位数（popcount）
+func (b *BitSet) Count() uint {
+	var c int
+	for _, w := range b.words {
+		c += bits.OnesCount64(w)
+	}
+	return uint(c)
+}
+
+// Any 是否存在为1的位
+func (b *BitSet) Any() bool {
+	for _, w := range b.words {
+		if w != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// NextSet 返回下标大于等于i的第一个为1的位，不存在时返回false
+// 遍历所有为1的位：for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {}
+func (b *BitSet) NextSet(i uint) (uint, bool) {
+	if i >= b.length {
+		return 0, false
+	}
+	x := i / wordSize
+	// 屏蔽掉当前字中低于i的位
+	w := b.words[x] >> (i % wordSize)
+	if w != 0 {
+		return i + uint(bits.TrailingZeros64(w)), true
+	}
+	for x++; x < uint(len(b.words)); x++ {
+		if b.words[x] != 0 {
+			return x*wordSize + uint(bits.TrailingZeros64(b.words[x])), true
+		}
+	}
+	return 0, false
+}
+
+// NextClear 返回下标大于等于i且小于长度的第一个为0的位，不存在时返回false
+func (b *BitSet) NextClear(i uint) (uint, bool) {
+	if i >= b.length {
+		return 0, false
+	}
+	x := i / wordSize
+	// 取反后找第一个为1的位
+	w := ^b.words[x] >> (i % wordSize)
+	if w != 0 {
+		idx := i + uint(bits.TrailingZeros64(w))
+		return idx, idx < b.length
+	}
+	for x++; x < uint(len(b.words)); x++ {
+		if b.words[x] != ^uint64(0) {
+			idx := x*wordSize + uint(bits.TrailingZeros64(^b.words[x]))
+			return idx, idx < b.length
+		}
+	}
+	return 0, false
+}
+
+// And 与运算，b = b & other
+func (b *BitSet) And(other *BitSet) *BitSet {
+	n := minInt(len(b.words), len(other.words))
+	for i := 0; i < n; i++ {
+		b.words[i] &= other.words[i]
+	}
+	// other中不存在的位视为0
+	for i := n; i < len(b.words); i++ {
+		b.words[i] = 0
+	}
+	return b
+}
+
+// Or 或运算，b = b | other，长度不足时扩容
+func (b *BitSet) Or(other *BitSet) *BitSet {
+	b.grow(other.length)
+	for i, w := range other.words {
+		b.words[i] |= w
+	}
+	return b
+}
+
+// Xor 异或运算，b = b ^ other，长度不足时扩容
+func (b *BitSet) Xor(other *BitSet) *BitSet {
+	b.grow(other.length)
+	for i, w := range other.words {
+		b.words[i] ^= w
+	}
+	return b
+}
+
+// AndNot 差集运算，b = b &^ other，即清除other中为1的位
+func (b *BitSet) AndNot(other *BitSet) *BitSet {
+	n := minInt(len(b.words), len(other.words))
+	for i := 0; i < n; i++ {
+		b.words[i] &^= other.words[i]
+	}
+	return b
+}
+
+// Clone 复制一个新的位图
+func (b *BitSet) Clone() *BitSet {
+	c := &BitSet{
+		words:  make([]uint64, len(b.words)),
+		length: b.length,
+	}
+	copy(c.words, b.words)
+	return c
+}
+
+// Equal 判断两个位图为1的位是否完全相同，忽略长度差异
+func (b *BitSet) Equal(other *BitSet) bool {
+	short, long := b.words, other.words
+	if len(short) > len(long) {
+		short, long = long, short
+	}
+	for i := range short {
+		if short[i] != long[i] {
+			return false
+		}
+	}
+	for _, w := range long[len(short):] {
+		if w != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// String 以{1 3 5}的形式返回所有为1的位
+func (b *BitSet) String() string {
+	var sb strings.Builder
+	sb.WriteString("{")
+	first := true
+	for i, ok := b.NextSet(0); ok; i, ok = b.NextSet(i + 1) {
+		if !first {
+			sb.WriteString(" ")
+		}
+		sb.WriteString(fmt.Sprint(i))
+		first = false
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// grow 保证长度至少为length
+func (b *BitSet) grow(length uint) {
+	if length <= b.length {
+		return
+	}
+	need := wordsNeeded(length)
+	if need > len(b.words) {
+		if need <= cap(b.words) {
+			b.words = b.words[:need]
+		} else {
+			// 按两倍扩容，摊还O(1)
+			words := make([]uint64, need, 2*need)
+			copy(words, b.words)
+			b.words = words
+		}
+	}
+	b.length = length
+}
+
+// wordsNeeded 存放length位需要的字数
+func wordsNeeded(length uint) int {
+	return int((length + wordSize - 1) / wordSize)
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
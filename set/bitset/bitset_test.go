@@ -0,0 +1,103 @@
+package bitset
+
+import (
+	"testing"
+)
+
+func TestBitSet_Basic(t *testing.T) {
+	b := New(10)
+	b.Set(1).Set(3).Set(200)
+	if b.Len() != 201 {
+		t.Errorf("Len() = %d, want 201", b.Len())
+	}
+	if !b.Test(3) || b.Test(2) || b.Test(1000) {
+		t.Errorf("Test() mismatch")
+	}
+	b.Flip(3).Flip(4).Clear(1).Clear(5000)
+	if b.Test(3) || !b.Test(4) || b.Test(1) {
+		t.Errorf("Flip()/Clear() mismatch")
+	}
+	if b.Count() != 2 {
+		t.Errorf("Count() = %d, want 2", b.Count())
+	}
+	if b.String() != "{4 200}" {
+		t.Errorf("String() = %s", b.String())
+	}
+}
+
+func TestBitSet_Next(t *testing.T) {
+	b := New(130)
+	for _, i := range []uint{0, 1, 2, 63, 64, 127} {
+		b.Set(i)
+	}
+
+	type testCase struct {
+		name    string
+		from    uint
+		set     uint
+		setOk   bool
+		clear   uint
+		clearOk bool
+	}
+	tests := []testCase{
+		{name: "start", from: 0, set: 0, setOk: true, clear: 3, clearOk: true},
+		{name: "word_boundary", from: 63, set: 63, setOk: true, clear: 65, clearOk: true},
+		{name: "next_word", from: 65, set: 127, setOk: true, clear: 65, clearOk: true},
+		{name: "tail", from: 128, setOk: false, clear: 128, clearOk: true},
+		{name: "out_of_range", from: 130, setOk: false, clearOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if i, ok := b.NextSet(tt.from); ok != tt.setOk || (ok && i != tt.set) {
+				t.Errorf("NextSet(%d) = %d, %v", tt.from, i, ok)
+			}
+			if i, ok := b.NextClear(tt.from); ok != tt.clearOk || (ok && i != tt.clear) {
+				t.Errorf("NextClear(%d) = %d, %v", tt.from, i, ok)
+			}
+		})
+	}
+
+	full := New(64)
+	for i := uint(0); i < 64; i++ {
+		full.Set(i)
+	}
+	if _, ok := full.NextClear(0); ok {
+		t.Errorf("NextClear() on full bitset")
+	}
+}
+
+func TestBitSet_Ops(t *testing.T) {
+	newOf := func(values ...uint) *BitSet {
+		b := New(0)
+		for _, v := range values {
+			b.Set(v)
+		}
+		return b
+	}
+	a := newOf(1, 2, 3, 100)
+	b := newOf(2, 3, 4)
+
+	type testCase struct {
+		name string
+		got  *BitSet
+		want *BitSet
+	}
+	tests := []testCase{
+		{name: "and", got: a.Clone().And(b), want: newOf(2, 3)},
+		{name: "or", got: a.Clone().Or(b), want: newOf(1, 2, 3, 4, 100)},
+		{name: "xor", got: a.Clone().Xor(b), want: newOf(1, 4, 100)},
+		{name: "and_not", got: a.Clone().AndNot(b), want: newOf(1, 100)},
+		{name: "short_or_long", got: b.Clone().Or(a), want: newOf(1, 2, 3, 4, 100)},
+		{name: "short_and_long", got: b.Clone().And(a), want: newOf(2, 3)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(tt.want) {
+				t.Errorf("%s = %s, want %s", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+	if a.Count() != 4 {
+		t.Errorf("Clone() shares storage")
+	}
+}
@@ -0,0 +1,139 @@
+package hashset
+
+import (
+	"fmt"
+	dsset "github.com/dairongpeng/ds/set"
+)
+
+// Set 基于内置map实现的哈希集合，元素无序
+type Set[T comparable] struct {
+	items map[T]struct{}
+}
+
+// New 初始化一个哈希集合
+func New[T comparable](values ...T) *Set[T] {
+	s := &Set[T]{
+		items: make(map[T]struct{}, len(values)),
+	}
+	for _, v := range values {
+		s.Add(v)
+	}
+	return s
+}
+
+// Add 添加一个元素，已存在时不做处理
+func (s *Set[T]) Add(value T) {
+	s.items[value] = struct{}{}
+}
+
+// Remove 删除一个元素
+func (s *Set[T]) Remove(value T) {
+	delete(s.items, value)
+}
+
+// Contains 判断元素是否存在
+func (s *Set[T]) Contains(value T) bool {
+	_, ok := s.items[value]
+	return ok
+}
+
+// Size 返回元素的个数
+func (s *Set[T]) Size() int {
+	return len(s.items)
+}
+
+// IsEmpty 判断集合是否为空
+func (s *Set[T]) IsEmpty() bool {
+	return len(s.items) == 0
+}
+
+// Clear 清空集合
+func (s *Set[T]) Clear() {
+	s.items = make(map[T]struct{})
+}
+
+// Values 返回所有元素，顺序不做保证
+func (s *Set[T]) Values() []T {
+	values := make([]T, 0, len(s.items))
+	for v := range s.items {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Union 并集
+func (s *Set[T]) Union(other dsset.DSSet[T]) dsset.DSSet[T] {
+	result := New[T]()
+	for v := range s.items {
+		result.Add(v)
+	}
+	for _, v := range other.Values() {
+		result.Add(v)
+	}
+	return result
+}
+
+// Intersection 交集
+func (s *Set[T]) Intersection(other dsset.DSSet[T]) dsset.DSSet[T] {
+	result := New[T]()
+	for v := range s.items {
+		if other.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// Difference 差集，属于s但不属于other的元素
+func (s *Set[T]) Difference(other dsset.DSSet[T]) dsset.DSSet[T] {
+	result := New[T]()
+	for v := range s.items {
+		if !other.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference 对称差集
+func (s *Set[T]) SymmetricDifference(other dsset.DSSet[T]) dsset.DSSet[T] {
+	result := New[T]()
+	for v := range s.items {
+		if !other.Contains(v) {
+			result.Add(v)
+		}
+	}
+	for _, v := range other.Values() {
+		if !s.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// IsSubset s中的元素是否都属于other
+func (s *Set[T]) IsSubset(other dsset.DSSet[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	for v := range s.items {
+		if !other.Contains(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal s和other的元素是否完全相同
+func (s *Set[T]) Equal(other dsset.DSSet[T]) bool {
+	return s.Size() == other.Size() && s.IsSubset(other)
+}
+
+// Print 打印集合
+func (s *Set[T]) Print() {
+	fmt.Println("Hash Set: ")
+	for v := range s.items {
+		fmt.Print(v, " ")
+	}
+	fmt.Println()
+}
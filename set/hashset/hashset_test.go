@@ -0,0 +1,62 @@
+package hashset
+
+import (
+	dsset "github.com/dairongpeng/ds/set"
+	"sort"
+	"testing"
+)
+
+func sorted(s dsset.DSSet[int]) []int {
+	values := s.Values()
+	sort.Ints(values)
+	return values
+}
+
+func TestSet_Algebra(t *testing.T) {
+	a := New[int](1, 2, 3, 4)
+	b := New[int](3, 4, 5)
+
+	type testCase struct {
+		name string
+		got  dsset.DSSet[int]
+		want []int
+	}
+	tests := []testCase{
+		{name: "union", got: a.Union(b), want: []int{1, 2, 3, 4, 5}},
+		{name: "intersection", got: a.Intersection(b), want: []int{3, 4}},
+		{name: "difference", got: a.Difference(b), want: []int{1, 2}},
+		{name: "symmetric_difference", got: a.SymmetricDifference(b), want: []int{1, 2, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.Equal(New[int](tt.want...)) {
+				t.Errorf("%s = %v, want %v", tt.name, sorted(tt.got), tt.want)
+			}
+		})
+	}
+
+	if a.IsSubset(b) || !New[int](3, 4).IsSubset(a) {
+		t.Errorf("IsSubset() mismatch")
+	}
+	if a.Equal(New[int](1, 2, 3)) || !a.Equal(New[int](4, 3, 2, 1)) {
+		t.Errorf("Equal() mismatch")
+	}
+}
+
+func TestSet_AddRemove(t *testing.T) {
+	s := New[string]()
+	s.Add("a")
+	s.Add("a")
+	s.Add("b")
+	if s.Size() != 2 || !s.Contains("a") {
+		t.Errorf("Size() = %d", s.Size())
+	}
+	s.Remove("a")
+	if s.Contains("a") || s.Size() != 1 {
+		t.Errorf("Remove() failed")
+	}
+	s.Clear()
+	if !s.IsEmpty() {
+		t.Errorf("Clear() failed")
+	}
+}
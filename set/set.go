@@ -1,7 +1,24 @@
 package dsset
 
+// DSSet 集合结构，元素不重复
+// 集合运算的参数可以是任意DSSet实现，返回结果和接收者是同一种实现
 type DSSet[T any] interface {
 	Add(value T)
 	Remove(value T)
 	Contains(value T) bool
+	Size() int
+	IsEmpty() bool
+	Values() []T
+	// Union 并集，属于a或者属于b的元素
+	Union(other DSSet[T]) DSSet[T]
+	// Intersection 交集，既属于a又属于b的元素
+	Intersection(other DSSet[T]) DSSet[T]
+	// Difference 差集，属于a但不属于b的元素
+	Difference(other DSSet[T]) DSSet[T]
+	// SymmetricDifference 对称差集，只属于a或只属于b的元素
+	SymmetricDifference(other DSSet[T]) DSSet[T]
+	// IsSubset a中的元素是否都属于b
+	IsSubset(other DSSet[T]) bool
+	// Equal a和b的元素是否完全相同
+	Equal(other DSSet[T]) bool
 }
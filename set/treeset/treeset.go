@@ -0,0 +1,160 @@
+package treeset
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/pkg"
+	dsset "github.com/dairongpeng/ds/set"
+	"github.com/dairongpeng/ds/tree/rbtree"
+)
+
+// Set 基于红黑树实现的有序集合，元素的顺序和相等性由比较器决定
+type Set[T any] struct {
+	tree *rbtree.Tree[T]
+	cmp  pkg.Comparator[T]
+}
+
+// New 初始化一个有序集合，comparator决定元素的顺序
+func New[T any](comparator pkg.Comparator[T], values ...T) *Set[T] {
+	return &Set[T]{
+		tree: rbtree.New[T](comparator, values...),
+		cmp:  comparator,
+	}
+}
+
+// Add 添加一个元素，已存在时不做处理
+func (s *Set[T]) Add(value T) {
+	if !s.tree.Search(value) {
+		s.tree.Insert(value)
+	}
+}
+
+// Remove 删除一个元素
+func (s *Set[T]) Remove(value T) {
+	s.tree.Delete(value)
+}
+
+// Contains 判断元素是否存在
+func (s *Set[T]) Contains(value T) bool {
+	return s.tree.Search(value)
+}
+
+// Size 返回元素的个数
+func (s *Set[T]) Size() int {
+	return s.tree.Size()
+}
+
+// IsEmpty 判断集合是否为空
+func (s *Set[T]) IsEmpty() bool {
+	return s.tree.IsEmpty()
+}
+
+// Clear 清空集合
+func (s *Set[T]) Clear() {
+	s.tree.Clear()
+}
+
+// Values 按从小到大的顺序返回所有元素
+func (s *Set[T]) Values() []T {
+	values := make([]T, 0, s.tree.Size())
+	it := s.tree.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Min 返回最小的元素
+func (s *Set[T]) Min() (T, bool) {
+	return s.tree.Min()
+}
+
+// Max 返回最大的元素
+func (s *Set[T]) Max() (T, bool) {
+	return s.tree.Max()
+}
+
+// Floor 返回小于等于value的最大元素
+func (s *Set[T]) Floor(value T) (T, bool) {
+	return s.tree.Floor(value)
+}
+
+// Ceiling 返回大于等于value的最小元素
+func (s *Set[T]) Ceiling(value T) (T, bool) {
+	return s.tree.Ceiling(value)
+}
+
+// Union 并集
+func (s *Set[T]) Union(other dsset.DSSet[T]) dsset.DSSet[T] {
+	result := New[T](s.cmp, s.Values()...)
+	for _, v := range other.Values() {
+		result.Add(v)
+	}
+	return result
+}
+
+// Intersection 交集
+func (s *Set[T]) Intersection(other dsset.DSSet[T]) dsset.DSSet[T] {
+	result := New[T](s.cmp)
+	for _, v := range s.Values() {
+		if other.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// Difference 差集，属于s但不属于other的元素
+func (s *Set[T]) Difference(other dsset.DSSet[T]) dsset.DSSet[T] {
+	result := New[T](s.cmp)
+	for _, v := range s.Values() {
+		if !other.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// SymmetricDifference 对称差集
+func (s *Set[T]) SymmetricDifference(other dsset.DSSet[T]) dsset.DSSet[T] {
+	result := New[T](s.cmp)
+	for _, v := range s.Values() {
+		if !other.Contains(v) {
+			result.Add(v)
+		}
+	}
+	for _, v := range other.Values() {
+		if !s.Contains(v) {
+			result.Add(v)
+		}
+	}
+	return result
+}
+
+// IsSubset s中的元素是否都属于other
+func (s *Set[T]) IsSubset(other dsset.DSSet[T]) bool {
+	if s.Size() > other.Size() {
+		return false
+	}
+	it := s.tree.Iterator()
+	for it.Next() {
+		if !other.Contains(it.Value()) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal s和other的元素是否完全相同
+func (s *Set[T]) Equal(other dsset.DSSet[T]) bool {
+	return s.Size() == other.Size() && s.IsSubset(other)
+}
+
+// Print 按从小到大的顺序打印集合
+func (s *Set[T]) Print() {
+	fmt.Println("Tree Set: ")
+	it := s.tree.Iterator()
+	for it.Next() {
+		fmt.Print(it.Value(), " ")
+	}
+	fmt.Println()
+}
@@ -0,0 +1,59 @@
+package treeset
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	dsset "github.com/dairongpeng/ds/set"
+	"github.com/dairongpeng/ds/set/hashset"
+	"reflect"
+	"testing"
+)
+
+func TestSet_Algebra(t *testing.T) {
+	a := New[int](pkg.NumberComparator[int], 4, 3, 2, 1)
+	// 参数可以是其他DSSet实现
+	b := hashset.New[int](3, 4, 5)
+
+	type testCase struct {
+		name string
+		got  dsset.DSSet[int]
+		want []int
+	}
+	tests := []testCase{
+		{name: "union", got: a.Union(b), want: []int{1, 2, 3, 4, 5}},
+		{name: "intersection", got: a.Intersection(b), want: []int{3, 4}},
+		{name: "difference", got: a.Difference(b), want: []int{1, 2}},
+		{name: "symmetric_difference", got: a.SymmetricDifference(b), want: []int{1, 2, 5}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// treeset的结果有序
+			if got := tt.got.Values(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+			}
+		})
+	}
+
+	if a.IsSubset(b) || !New[int](pkg.NumberComparator[int], 3, 4).IsSubset(a) {
+		t.Errorf("IsSubset() mismatch")
+	}
+	if !a.Equal(hashset.New[int](1, 2, 3, 4)) || a.Equal(b) {
+		t.Errorf("Equal() mismatch")
+	}
+}
+
+func TestSet_Ordered(t *testing.T) {
+	s := New[int](pkg.NumberComparator[int], 30, 10, 20, 10)
+	if s.Size() != 3 || !reflect.DeepEqual(s.Values(), []int{10, 20, 30}) {
+		t.Errorf("Values() = %v", s.Values())
+	}
+	if v, ok := s.Floor(25); !ok || v != 20 {
+		t.Errorf("Floor(25) = %d, %v", v, ok)
+	}
+	if v, ok := s.Ceiling(25); !ok || v != 30 {
+		t.Errorf("Ceiling(25) = %d, %v", v, ok)
+	}
+	s.Remove(10)
+	if v, _ := s.Min(); v != 20 {
+		t.Errorf("Min() = %d", v)
+	}
+}
@@ -0,0 +1,116 @@
+package skiplist
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"sync"
+)
+
+// ConcurrentSkipList 读写锁保护的跳表，允许多个读者并发读取，写者独占
+// 迭代器无法跨越加锁范围，所以范围遍历以回调的方式提供，回调执行期间持有读锁，回调中不能写入该跳表
+type ConcurrentSkipList[K any, V any] struct {
+	mu sync.RWMutex
+	s  *SkipList[K, V]
+}
+
+// NewConcurrent 初始化一个并发安全的跳表
+func NewConcurrent[K any, V any](comparator pkg.Comparator[K]) *ConcurrentSkipList[K, V] {
+	return &ConcurrentSkipList[K, V]{
+		s: New[K, V](comparator),
+	}
+}
+
+// Insert 添加一个键值对，如果key已经存在则覆盖原来的value
+func (c *ConcurrentSkipList[K, V]) Insert(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.s.Insert(key, value)
+}
+
+// Delete 删除key对应的键值对，key不存在时返回false
+func (c *ConcurrentSkipList[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.s.Delete(key)
+}
+
+// Search 通过key获取value
+func (c *ConcurrentSkipList[K, V]) Search(key K) (V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Search(key)
+}
+
+// Contains 判断key是否存在
+func (c *ConcurrentSkipList[K, V]) Contains(key K) bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Contains(key)
+}
+
+// Size 返回键值对的个数
+func (c *ConcurrentSkipList[K, V]) Size() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Size()
+}
+
+// Min 返回最小的key及其value
+func (c *ConcurrentSkipList[K, V]) Min() (K, V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Min()
+}
+
+// Max 返回最大的key及其value
+func (c *ConcurrentSkipList[K, V]) Max() (K, V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Max()
+}
+
+// Rank 返回严格小于key的元素个数
+func (c *ConcurrentSkipList[K, V]) Rank(key K) int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Rank(key)
+}
+
+// Select 返回第k小的键值对，k从0开始
+func (c *ConcurrentSkipList[K, V]) Select(k int) (K, V, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Select(k)
+}
+
+// Keys 按从小到大的顺序返回所有key的快照
+func (c *ConcurrentSkipList[K, V]) Keys() []K {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Keys()
+}
+
+// Values 按key从小到大的顺序返回所有value的快照
+func (c *ConcurrentSkipList[K, V]) Values() []V {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.s.Values()
+}
+
+// Each 持有读锁，按key从小到大的顺序遍历所有键值对，f返回false时提前终止遍历
+func (c *ConcurrentSkipList[K, V]) Each(f func(key K, value V) bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	c.s.Each(f)
+}
+
+// Range 持有读锁，按key从小到大的顺序遍历闭区间[lo, hi]内的键值对，f返回false时提前终止遍历
+func (c *ConcurrentSkipList[K, V]) Range(lo, hi K, f func(key K, value V) bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	it := c.s.Range(lo, hi)
+	for it.Next() {
+		if !f(it.Key(), it.Value()) {
+			return
+		}
+	}
+}
@@ -0,0 +1,339 @@
+// Package skiplist 跳表, 通过随机层数的多级索引实现O(logN)的有序查找
+package skiplist
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/pkg"
+	"math/rand"
+	"time"
+)
+
+const (
+	// 最大层数，按1/4的晋升概率足以容纳4^32个元素
+	maxLevel = 32
+	// 晋升到上一层的概率的倒数，即每个节点有1/4的概率多一层索引
+	promotion = 4
+)
+
+// node 跳表节点
+type node[K any, V any] struct {
+	key   K
+	value V
+	// 每一层指向的下一个节点
+	next []*node[K, V]
+	// 每一层到下一个节点之间跨越的节点数（第0层的步数），用于计算排名
+	span []int
+}
+
+// SkipList 跳表实现的有序Map，key的顺序由比较器决定
+// 第0层是包含所有节点的有序链表，每个节点以1/4的概率出现在更高一层，高层作为低层的索引
+// 查找时从最高层开始，能向右就向右，不能向右就下降一层，期望时间复杂度O(logN)
+// 每层额外记录跨度span，可以在查找的同时累加得到排名
+type SkipList[K any, V any] struct {
+	// 头节点不存数据，拥有最大层数的指针
+	head *node[K, V]
+	// 当前最高的层数
+	level int
+	size  int
+	cmp   pkg.Comparator[K]
+	rand  *rand.Rand
+}
+
+// New 初始化一个跳表，comparator决定key的顺序
+func New[K any, V any](comparator pkg.Comparator[K]) *SkipList[K, V] {
+	return &SkipList[K, V]{
+		head:  newNode[K, V](maxLevel),
+		level: 1,
+		cmp:   comparator,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func newNode[K any, V any](level int) *node[K, V] {
+	return &node[K, V]{
+		next: make([]*node[K, V], level),
+		span: make([]int, level),
+	}
+}
+
+// Insert 添加一个键值对，如果key已经存在则覆盖原来的value
+func (s *SkipList[K, V]) Insert(key K, value V) {
+	// update[i] 第i层上最后一个小于key的节点，即新节点在第i层的前驱
+	var update [maxLevel]*node[K, V]
+	// rank[i] update[i]的排名（从头节点走到update[i]经过的节点数）
+	var rank [maxLevel]int
+
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		if i < s.level-1 {
+			rank[i] = rank[i+1]
+		}
+		for x.next[i] != nil && s.cmp(x.next[i].key, key) < 0 {
+			rank[i] += x.span[i]
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	// key已存在，覆盖value
+	if n := x.next[0]; n != nil && s.cmp(n.key, key) == 0 {
+		n.value = value
+		return
+	}
+
+	lvl := s.randomLevel()
+	// 新节点比当前所有层都高，新增的层前驱都是头节点，头节点在这些层上跨越了整个链表
+	if lvl > s.level {
+		for i := s.level; i < lvl; i++ {
+			rank[i] = 0
+			update[i] = s.head
+			update[i].span[i] = s.size
+		}
+		s.level = lvl
+	}
+
+	n := newNode[K, V](lvl)
+	n.key = key
+	n.value = value
+	for i := 0; i < lvl; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+		// rank[0] - rank[i] 是第i层前驱到新节点前一个位置的距离
+		n.span[i] = update[i].span[i] - (rank[0] - rank[i])
+		update[i].span[i] = rank[0] - rank[i] + 1
+	}
+	// 新节点没有到达的层，前驱跨越的节点数加一
+	for i := lvl; i < s.level; i++ {
+		update[i].span[i]++
+	}
+	s.size++
+}
+
+// Delete 删除key对应的键值对，key不存在时返回false
+func (s *SkipList[K, V]) Delete(key K) bool {
+	var update [maxLevel]*node[K, V]
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.cmp(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+		update[i] = x
+	}
+
+	x = x.next[0]
+	if x == nil || s.cmp(x.key, key) != 0 {
+		return false
+	}
+
+	for i := 0; i < s.level; i++ {
+		if update[i].next[i] == x { // 该层上有x，前驱跳过x，跨度合并
+			update[i].span[i] += x.span[i] - 1
+			update[i].next[i] = x.next[i]
+		} else { // 该层上没有x，只是跨度减一
+			update[i].span[i]--
+		}
+	}
+	// 最高层如果已经空了，降低层数
+	for s.level > 1 && s.head.next[s.level-1] == nil {
+		s.level--
+	}
+	s.size--
+	return true
+}
+
+// Search 通过key获取value，如果key不存在则返回一个零值和false
+func (s *SkipList[K, V]) Search(key K) (V, bool) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.cmp(x.next[i].key, key) < 0 {
+			x = x.next[i]
+		}
+	}
+	x = x.next[0]
+	if x != nil && s.cmp(x.key, key) == 0 {
+		return x.value, true
+	}
+	var zeroValue V
+	return zeroValue, false
+}
+
+// Contains 判断key是否存在
+func (s *SkipList[K, V]) Contains(key K) bool {
+	_, ok := s.Search(key)
+	return ok
+}
+
+// Size 返回键值对的个数
+func (s *SkipList[K, V]) Size() int {
+	return s.size
+}
+
+// IsEmpty 判断跳表是否为空
+func (s *SkipList[K, V]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Min 返回最小的key及其value
+func (s *SkipList[K, V]) Min() (K, V, bool) {
+	return entry(s.head.next[0])
+}
+
+// Max 返回最大的key及其value
+func (s *SkipList[K, V]) Max() (K, V, bool) {
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil {
+			x = x.next[i]
+		}
+	}
+	if x == s.head {
+		return entry[K, V](nil)
+	}
+	return entry(x)
+}
+
+// Rank 返回严格小于key的元素个数，key不需要在跳表中
+func (s *SkipList[K, V]) Rank(key K) int {
+	rank := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.cmp(x.next[i].key, key) < 0 {
+			rank += x.span[i]
+			x = x.next[i]
+		}
+	}
+	return rank
+}
+
+// Select 返回第k小的键值对，k从0开始。k越界时返回false
+// 排行榜场景下，Select(Size()-1-k)即为第k名
+func (s *SkipList[K, V]) Select(k int) (K, V, bool) {
+	if k < 0 || k >= s.size {
+		return entry[K, V](nil)
+	}
+	// 需要从头节点向右走k+1步
+	target := k + 1
+	traversed := 0
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && traversed+x.span[i] <= target {
+			traversed += x.span[i]
+			x = x.next[i]
+		}
+		if traversed == target {
+			return entry(x)
+		}
+	}
+	return entry[K, V](nil)
+}
+
+// Keys 按从小到大的顺序返回所有的key
+func (s *SkipList[K, V]) Keys() []K {
+	keys := make([]K, 0, s.size)
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		keys = append(keys, x.key)
+	}
+	return keys
+}
+
+// Values 按key从小到大的顺序返回所有的value
+func (s *SkipList[K, V]) Values() []V {
+	values := make([]V, 0, s.size)
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		values = append(values, x.value)
+	}
+	return values
+}
+
+// Each 按key从小到大的顺序遍历所有键值对，f返回false时提前终止遍历
+func (s *SkipList[K, V]) Each(f func(key K, value V) bool) {
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		if !f(x.key, x.value) {
+			return
+		}
+	}
+}
+
+// Iterator 返回按key从小到大顺序的迭代器
+func (s *SkipList[K, V]) Iterator() *Iterator[K, V] {
+	return &Iterator[K, V]{next: s.head.next[0]}
+}
+
+// Range 返回key落在闭区间[lo, hi]内的有序迭代器
+func (s *SkipList[K, V]) Range(lo, hi K) *Iterator[K, V] {
+	it := &Iterator[K, V]{
+		hi:    hi,
+		bound: true,
+		cmp:   s.cmp,
+	}
+	if s.cmp(lo, hi) > 0 {
+		return it
+	}
+	// 找到第一个大于等于lo的节点
+	x := s.head
+	for i := s.level - 1; i >= 0; i-- {
+		for x.next[i] != nil && s.cmp(x.next[i].key, lo) < 0 {
+			x = x.next[i]
+		}
+	}
+	it.next = x.next[0]
+	return it
+}
+
+// Print 按key的顺序打印跳表
+func (s *SkipList[K, V]) Print() {
+	fmt.Println("Skip List: ")
+	for x := s.head.next[0]; x != nil; x = x.next[0] {
+		fmt.Print(x.key, ":", x.value, " ")
+	}
+	fmt.Println()
+}
+
+// randomLevel 随机生成新节点的层数，层数为k的概率是(1/4)^(k-1) * 3/4
+func (s *SkipList[K, V]) randomLevel() int {
+	lvl := 1
+	for lvl < maxLevel && s.rand.Intn(promotion) == 0 {
+		lvl++
+	}
+	return lvl
+}
+
+// Iterator 跳表的有序迭代器，迭代期间不能修改跳表
+type Iterator[K any, V any] struct {
+	next *node[K, V]
+	cur  *node[K, V]
+	// 是否有上界
+	bound bool
+	hi    K
+	cmp   pkg.Comparator[K]
+}
+
+// Next 移动到下一个键值对，没有更多元素时返回false
+func (it *Iterator[K, V]) Next() bool {
+	if it.next == nil || (it.bound && it.cmp(it.next.key, it.hi) > 0) {
+		it.next = nil
+		return false
+	}
+	it.cur = it.next
+	it.next = it.next.next[0]
+	return true
+}
+
+// Key 返回当前位置的key
+func (it *Iterator[K, V]) Key() K {
+	return it.cur.key
+}
+
+// Value 返回当前位置的value
+func (it *Iterator[K, V]) Value() V {
+	return it.cur.value
+}
+
+func entry[K any, V any](n *node[K, V]) (K, V, bool) {
+	if n == nil {
+		var zeroKey K
+		var zeroValue V
+		return zeroKey, zeroValue, false
+	}
+	return n.key, n.value, true
+}
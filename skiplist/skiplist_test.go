@@ -0,0 +1,127 @@
+package skiplist
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"math/rand"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestSkipList(t *testing.T) {
+	s := New[int, string](pkg.NumberComparator[int])
+	s.Insert(30, "c")
+	s.Insert(10, "a")
+	s.Insert(20, "b")
+	s.Insert(20, "bb")
+
+	if s.Size() != 3 {
+		t.Errorf("Size() = %d, want 3", s.Size())
+	}
+	if v, ok := s.Search(20); !ok || v != "bb" {
+		t.Errorf("Search(20) = %v, %v", v, ok)
+	}
+	if !reflect.DeepEqual(s.Keys(), []int{10, 20, 30}) {
+		t.Errorf("Keys() = %v", s.Keys())
+	}
+	if k, _, ok := s.Min(); !ok || k != 10 {
+		t.Errorf("Min() = %v, %v", k, ok)
+	}
+	if k, _, ok := s.Max(); !ok || k != 30 {
+		t.Errorf("Max() = %v, %v", k, ok)
+	}
+	if !s.Delete(10) || s.Delete(10) || s.Contains(10) {
+		t.Errorf("Delete(10) mismatch")
+	}
+
+	got := make([]int, 0)
+	it := s.Range(15, 40)
+	for it.Next() {
+		got = append(got, it.Key())
+	}
+	if !reflect.DeepEqual(got, []int{20, 30}) {
+		t.Errorf("Range(15, 40) = %v", got)
+	}
+}
+
+func TestSkipList_Random(t *testing.T) {
+	s := New[int, int](pkg.NumberComparator[int])
+	ref := make(map[int]int)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 10000; i++ {
+		k := r.Intn(1000)
+		if r.Intn(3) == 0 {
+			_, want := ref[k]
+			if ok := s.Delete(k); ok != want {
+				t.Fatalf("Delete(%d) = %v, want %v", k, ok, want)
+			}
+			delete(ref, k)
+		} else {
+			s.Insert(k, i)
+			ref[k] = i
+		}
+	}
+
+	keys := make([]int, 0, len(ref))
+	for k := range ref {
+		keys = append(keys, k)
+	}
+	sort.Ints(keys)
+	if s.Size() != len(keys) || !reflect.DeepEqual(s.Keys(), keys) {
+		t.Fatalf("Keys() mismatch")
+	}
+	for i, k := range keys {
+		if got := s.Rank(k); got != i {
+			t.Fatalf("Rank(%d) = %d, want %d", k, got, i)
+		}
+		if got, v, ok := s.Select(i); !ok || got != k || v != ref[k] {
+			t.Fatalf("Select(%d) = %d, %d, %v, want %d", i, got, v, ok, k)
+		}
+	}
+	if _, _, ok := s.Select(len(keys)); ok {
+		t.Errorf("Select(Size()) should be out of range")
+	}
+}
+
+func TestConcurrentSkipList(t *testing.T) {
+	s := NewConcurrent[int, int](pkg.NumberComparator[int])
+	var wg sync.WaitGroup
+
+	// 一个写者
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			s.Insert(i, i)
+		}
+	}()
+
+	// 多个读者
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				s.Search(i)
+				s.Rank(i)
+				prev := -1
+				s.Range(0, 100, func(key int, value int) bool {
+					if key <= prev {
+						t.Errorf("Range out of order: %d after %d", key, prev)
+					}
+					prev = key
+					return true
+				})
+			}
+		}()
+	}
+	wg.Wait()
+
+	if s.Size() != 1000 {
+		t.Errorf("Size() = %d, want 1000", s.Size())
+	}
+	if k, _, ok := s.Select(999); !ok || k != 999 {
+		t.Errorf("Select(999) = %d, %v", k, ok)
+	}
+}
@@ -0,0 +1,55 @@
+package linkedliststack
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/list/linkedlist"
+)
+
+// Stack 单链表实现的栈，链表头部即栈顶，Push和Pop都是O(1)且不会重新分配内存
+type Stack[T any] struct {
+	list *linkedlist.List[T]
+}
+
+// New 初始化一个栈，values依次压入栈中
+func New[T any](values ...T) *Stack[T] {
+	return &Stack[T]{list: linkedlist.New[T](values...)}
+}
+
+// Push 将元素v压入栈顶
+func (s *Stack[T]) Push(v T) {
+	s.list.Add(v)
+}
+
+// Pop 弹出栈顶元素，返回弹出的元素和一个bool值，如果栈为空则返回一个零值和false。
+func (s *Stack[T]) Pop() (T, bool) {
+	return s.list.Remove()
+}
+
+// Top 返回栈顶元素，但不将其弹出，如果栈为空则返回一个零值和false。
+func (s *Stack[T]) Top() (T, bool) {
+	if s.list.Head == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return s.list.Head.Value, true
+}
+
+// Size 返回栈的元素个数。
+func (s *Stack[T]) Size() int {
+	return s.list.Size()
+}
+
+// IsEmpty 判断栈是否为空。
+func (s *Stack[T]) IsEmpty() bool {
+	return s.list.IsEmpty()
+}
+
+// Print 从栈顶到栈底打印栈
+func (s *Stack[T]) Print() {
+	fmt.Println("Linked List Stack: ")
+	for cur := s.list.Head; cur != nil; cur = cur.Next {
+		fmt.Print(cur.Value, " ")
+	}
+
+	fmt.Println()
+}
@@ -0,0 +1,105 @@
+package minmaxstack
+
+import (
+	"fmt"
+	"github.com/dairongpeng/ds/pkg"
+)
+
+// entry 栈中的元素，同时记录压入该元素时栈中的最小值和最大值
+type entry[T any] struct {
+	value T
+	min   T
+	max   T
+}
+
+// Stack 可以在O(1)时间内获取最小值和最大值的栈
+// 每个元素都记录压入时栈中的最小值和最大值，弹出元素后栈顶记录的就是剩余元素的最值
+type Stack[T any] struct {
+	entries    []entry[T]
+	comparator pkg.Comparator[T]
+}
+
+// New 初始化一个栈，comparator决定元素的大小，values依次压入栈中
+func New[T any](comparator pkg.Comparator[T], values ...T) *Stack[T] {
+	s := &Stack[T]{comparator: comparator}
+	for _, v := range values {
+		s.Push(v)
+	}
+	return s
+}
+
+// Push 将元素v压入栈顶
+func (s *Stack[T]) Push(v T) {
+	e := entry[T]{value: v, min: v, max: v}
+	if n := len(s.entries); n > 0 {
+		top := s.entries[n-1]
+		if s.comparator(top.min, v) < 0 {
+			e.min = top.min
+		}
+		if s.comparator(top.max, v) > 0 {
+			e.max = top.max
+		}
+	}
+	s.entries = append(s.entries, e)
+}
+
+// Pop 弹出栈顶元素，返回弹出的元素和一个bool值，如果栈为空则返回一个零值和false。
+func (s *Stack[T]) Pop() (T, bool) {
+	if len(s.entries) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	index := len(s.entries) - 1
+	v := s.entries[index].value
+	// 清除引用，避免内存泄漏
+	s.entries[index] = entry[T]{}
+	s.entries = s.entries[:index]
+	return v, true
+}
+
+// Top 返回栈顶元素，但不将其弹出，如果栈为空则返回一个零值和false。
+func (s *Stack[T]) Top() (T, bool) {
+	if len(s.entries) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return s.entries[len(s.entries)-1].value, true
+}
+
+// Min 返回栈中的最小值，O(1)。如果栈为空则返回一个零值和false。
+func (s *Stack[T]) Min() (T, bool) {
+	if len(s.entries) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return s.entries[len(s.entries)-1].min, true
+}
+
+// Max 返回栈中的最大值，O(1)。如果栈为空则返回一个零值和false。
+func (s *Stack[T]) Max() (T, bool) {
+	if len(s.entries) == 0 {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return s.entries[len(s.entries)-1].max, true
+}
+
+// Size 返回栈的元素个数。
+func (s *Stack[T]) Size() int {
+	return len(s.entries)
+}
+
+// IsEmpty 判断栈是否为空。
+func (s *Stack[T]) IsEmpty() bool {
+	return len(s.entries) == 0
+}
+
+// Print 从栈底到栈顶打印栈
+func (s *Stack[T]) Print() {
+	fmt.Println("Min Max Stack: ")
+	for _, e := range s.entries {
+		fmt.Print(e.value, " ")
+	}
+
+	fmt.Println()
+}
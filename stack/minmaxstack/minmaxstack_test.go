@@ -0,0 +1,86 @@
+package minmaxstack
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"math/rand"
+	"testing"
+)
+
+func TestStack_MinMax(t *testing.T) {
+	s := New[int](pkg.NumberComparator[int])
+	if _, ok := s.Min(); ok {
+		t.Errorf("Min() on empty stack")
+	}
+	if _, ok := s.Max(); ok {
+		t.Errorf("Max() on empty stack")
+	}
+
+	type testCase struct {
+		name     string
+		push     int
+		min, max int
+	}
+	tests := []testCase{
+		{name: "first", push: 5, min: 5, max: 5},
+		{name: "smaller", push: 3, min: 3, max: 5},
+		{name: "larger", push: 8, min: 3, max: 8},
+		{name: "duplicate_min", push: 3, min: 3, max: 8},
+		{name: "middle", push: 4, min: 3, max: 8},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.Push(tt.push)
+			if v, _ := s.Min(); v != tt.min {
+				t.Errorf("Min() = %d, want %d", v, tt.min)
+			}
+			if v, _ := s.Max(); v != tt.max {
+				t.Errorf("Max() = %d, want %d", v, tt.max)
+			}
+		})
+	}
+
+	// 依次弹出，最值回退到之前的状态
+	for i := len(tests) - 1; i > 0; i-- {
+		s.Pop()
+		if v, _ := s.Min(); v != tests[i-1].min {
+			t.Errorf("after pop Min() = %d, want %d", v, tests[i-1].min)
+		}
+		if v, _ := s.Max(); v != tests[i-1].max {
+			t.Errorf("after pop Max() = %d, want %d", v, tests[i-1].max)
+		}
+	}
+}
+
+func TestStack_Random(t *testing.T) {
+	s := New[int](pkg.NumberComparator[int])
+	var model []int
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		if r.Intn(3) > 0 || len(model) == 0 {
+			v := r.Intn(1000)
+			s.Push(v)
+			model = append(model, v)
+		} else {
+			s.Pop()
+			model = model[:len(model)-1]
+		}
+		if len(model) == 0 {
+			continue
+		}
+		lo, hi := model[0], model[0]
+		for _, v := range model {
+			if v < lo {
+				lo = v
+			}
+			if v > hi {
+				hi = v
+			}
+		}
+		if v, _ := s.Min(); v != lo {
+			t.Fatalf("Min() = %d, want %d", v, lo)
+		}
+		if v, _ := s.Max(); v != hi {
+			t.Fatalf("Max() = %d, want %d", v, hi)
+		}
+	}
+}
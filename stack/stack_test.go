@@ -0,0 +1,95 @@
+package dsstack_test
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	dsstack "github.com/dairongpeng/ds/stack"
+	"github.com/dairongpeng/ds/stack/arraystack"
+	"github.com/dairongpeng/ds/stack/linkedliststack"
+	"github.com/dairongpeng/ds/stack/minmaxstack"
+	"math/rand"
+	"testing"
+)
+
+// stackImpls 参与一致性测试的DSStack实现，新增实现时在这里注册
+var stackImpls = []struct {
+	name     string
+	newStack func() dsstack.DSStack[int]
+}{
+	{name: "arraystack", newStack: func() dsstack.DSStack[int] { return arraystack.New[int]() }},
+	{name: "linkedliststack", newStack: func() dsstack.DSStack[int] { return linkedliststack.New[int]() }},
+	{name: "minmaxstack", newStack: func() dsstack.DSStack[int] { return minmaxstack.New[int](pkg.NumberComparator[int]) }},
+}
+
+func TestDSStack_Empty(t *testing.T) {
+	for _, impl := range stackImpls {
+		t.Run(impl.name, func(t *testing.T) {
+			s := impl.newStack()
+			if !s.IsEmpty() || s.Size() != 0 {
+				t.Errorf("new stack should be empty")
+			}
+			if _, ok := s.Pop(); ok {
+				t.Errorf("Pop() on empty stack")
+			}
+			if _, ok := s.Top(); ok {
+				t.Errorf("Top() on empty stack")
+			}
+		})
+	}
+}
+
+func TestDSStack_LIFO(t *testing.T) {
+	for _, impl := range stackImpls {
+		t.Run(impl.name, func(t *testing.T) {
+			s := impl.newStack()
+			for i := 0; i < 100; i++ {
+				s.Push(i)
+				if v, ok := s.Top(); !ok || v != i {
+					t.Fatalf("Top() = %d, %v, want %d", v, ok, i)
+				}
+			}
+			for i := 99; i >= 0; i-- {
+				if v, ok := s.Pop(); !ok || v != i {
+					t.Fatalf("Pop() = %d, %v, want %d", v, ok, i)
+				}
+				if s.Size() != i {
+					t.Fatalf("Size() = %d, want %d", s.Size(), i)
+				}
+			}
+			if !s.IsEmpty() {
+				t.Errorf("stack should be empty")
+			}
+		})
+	}
+}
+
+func TestDSStack_Random(t *testing.T) {
+	for _, impl := range stackImpls {
+		t.Run(impl.name, func(t *testing.T) {
+			s := impl.newStack()
+			var model []int
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 10000; i++ {
+				if r.Intn(3) > 0 {
+					s.Push(i)
+					model = append(model, i)
+					continue
+				}
+				v, ok := s.Pop()
+				if len(model) == 0 {
+					if ok {
+						t.Fatalf("Pop() on empty stack = %d", v)
+					}
+					continue
+				}
+				want := model[len(model)-1]
+				model = model[:len(model)-1]
+				if !ok || v != want {
+					t.Fatalf("Pop() = %d, %v, want %d", v, ok, want)
+				}
+				if s.Size() != len(model) {
+					t.Fatalf("Size() = %d, want %d", s.Size(), len(model))
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package avl
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"github.com/dairongpeng/ds/stack/arraystack"
+	dstree "github.com/dairongpeng/ds/tree"
+)
+
+// node AVL树节点，额外维护高度和子树大小
+type node[T any] struct {
+	value T
+	left  *node[T]
+	right *node[T]
+	// 以当前节点为头的树的高度
+	height int
+	// 以当前节点为头的树的节点个数，用于顺序统计
+	size int
+}
+
+// Tree AVL树，任意节点左右子树的高度差不超过1
+// 每次插入删除后，沿途向上检查平衡因子，通过LL、RR、LR、RL四种旋转恢复平衡，增删查的时间复杂度为O(logN)
+// 节点上额外维护子树大小，支持O(logN)的Select、Rank等顺序统计查询
+type Tree[T any] struct {
+	root *node[T]
+	cmp  pkg.Comparator[T]
+}
+
+// New 初始化一个AVL树，comparator决定元素的顺序
+func New[T any](comparator pkg.Comparator[T], values ...T) *Tree[T] {
+	t := &Tree[T]{
+		cmp: comparator,
+	}
+	for _, v := range values {
+		t.Insert(v)
+	}
+	return t
+}
+
+// Insert 插入一个元素，如果已经存在相等的元素则覆盖
+func (t *Tree[T]) Insert(value T) {
+	t.root = t.insert(t.root, value)
+}
+
+func (t *Tree[T]) insert(n *node[T], value T) *node[T] {
+	if n == nil {
+		return &node[T]{value: value, height: 1, size: 1}
+	}
+	c := t.cmp(value, n.value)
+	if c < 0 {
+		n.left = t.insert(n.left, value)
+	} else if c > 0 {
+		n.right = t.insert(n.right, value)
+	} else {
+		n.value = value
+		return n
+	}
+	return rebalance(n)
+}
+
+// Delete 删除一个元素，元素不存在时返回false
+func (t *Tree[T]) Delete(value T) bool {
+	if !t.Search(value) {
+		return false
+	}
+	t.root = t.delete(t.root, value)
+	return true
+}
+
+// delete 在以n为头的树中删除value（调用方保证存在），返回删除后新的头节点
+func (t *Tree[T]) delete(n *node[T], value T) *node[T] {
+	c := t.cmp(value, n.value)
+	if c < 0 {
+		n.left = t.delete(n.left, value)
+	} else if c > 0 {
+		n.right = t.delete(n.right, value)
+	} else {
+		if n.left == nil {
+			return n.right
+		}
+		if n.right == nil {
+			return n.left
+		}
+		// 左右孩子都有，用后继节点（右树的最左节点）顶替
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		successor.right = deleteMin(n.right)
+		successor.left = n.left
+		n = successor
+	}
+	return rebalance(n)
+}
+
+// deleteMin 删除以n为头的树中的最小节点，返回新的头节点
+func deleteMin[T any](n *node[T]) *node[T] {
+	if n.left == nil {
+		return n.right
+	}
+	n.left = deleteMin(n.left)
+	return rebalance(n)
+}
+
+// Search 查找元素是否存在
+func (t *Tree[T]) Search(value T) bool {
+	_, ok := t.Get(value)
+	return ok
+}
+
+// Get 查找和value相等的元素并返回
+func (t *Tree[T]) Get(value T) (T, bool) {
+	cur := t.root
+	for cur != nil {
+		c := t.cmp(value, cur.value)
+		if c == 0 {
+			return cur.value, true
+		}
+		if c < 0 {
+			cur = cur.left
+		} else {
+			cur = cur.right
+		}
+	}
+	var zeroValue T
+	return zeroValue, false
+}
+
+// Min 返回最小的元素
+func (t *Tree[T]) Min() (T, bool) {
+	if t.root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	cur := t.root
+	for cur.left != nil {
+		cur = cur.left
+	}
+	return cur.value, true
+}
+
+// Max 返回最大的元素
+func (t *Tree[T]) Max() (T, bool) {
+	if t.root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	cur := t.root
+	for cur.right != nil {
+		cur = cur.right
+	}
+	return cur.value, true
+}
+
+// Size 返回元素的个数
+func (t *Tree[T]) Size() int {
+	return size(t.root)
+}
+
+// IsEmpty 判断树是否为空
+func (t *Tree[T]) IsEmpty() bool {
+	return t.root == nil
+}
+
+// Height 返回树的高度，空树高度为0
+func (t *Tree[T]) Height() int {
+	return height(t.root)
+}
+
+// Select 返回第k小的元素，k从0开始。k越界时返回false
+// 即Select(0)是最小值，Select(Size()-1)是最大值，且Select(Rank(x)) == x
+func (t *Tree[T]) Select(k int) (T, bool) {
+	if k < 0 || k >= t.Size() {
+		var zeroValue T
+		return zeroValue, false
+	}
+	cur := t.root
+	for {
+		leftSize := size(cur.left)
+		if k < leftSize { // 第k小在左树
+			cur = cur.left
+		} else if k > leftSize { // 第k小在右树，跳过左树和当前节点
+			k -= leftSize + 1
+			cur = cur.right
+		} else {
+			return cur.value, true
+		}
+	}
+}
+
+// Rank 返回严格小于value的元素个数，value不需要在树中
+func (t *Tree[T]) Rank(value T) int {
+	rank := 0
+	cur := t.root
+	for cur != nil {
+		c := t.cmp(value, cur.value)
+		if c < 0 {
+			cur = cur.left
+		} else if c > 0 { // 左树和当前节点都比value小
+			rank += size(cur.left) + 1
+			cur = cur.right
+		} else {
+			return rank + size(cur.left)
+		}
+	}
+	return rank
+}
+
+// RangeCount 返回落在闭区间[lo, hi]内的元素个数
+func (t *Tree[T]) RangeCount(lo, hi T) int {
+	if t.cmp(lo, hi) > 0 {
+		return 0
+	}
+	count := t.Rank(hi) - t.Rank(lo)
+	if t.Search(hi) {
+		count++
+	}
+	return count
+}
+
+// Values 按从小到大的顺序返回所有元素
+func (t *Tree[T]) Values() []T {
+	values := make([]T, 0, t.Size())
+	it := t.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+// Iterator 返回中序迭代器，即按从小到大的顺序访问元素
+func (t *Tree[T]) Iterator() dstree.Iterator[T] {
+	it := &iterator[T]{
+		stack: arraystack.New[*node[T]](),
+	}
+	it.pushLeft(t.root)
+	return it
+}
+
+// iterator 借助栈实现的中序迭代器
+type iterator[T any] struct {
+	stack *arraystack.Stack[*node[T]]
+	cur   *node[T]
+}
+
+func (it *iterator[T]) Next() bool {
+	n, ok := it.stack.Pop()
+	if !ok {
+		return false
+	}
+	it.cur = n
+	it.pushLeft(n.right)
+	return true
+}
+
+func (it *iterator[T]) Value() T {
+	return it.cur.value
+}
+
+func (it *iterator[T]) pushLeft(n *node[T]) {
+	for n != nil {
+		it.stack.Push(n)
+		n = n.left
+	}
+}
+
+// rebalance 更新n的高度和大小，如果失衡则旋转，返回新的头节点
+func rebalance[T any](n *node[T]) *node[T] {
+	update(n)
+	bf := height(n.left) - height(n.right)
+	if bf > 1 {
+		// LR型，先对左孩子左旋转换成LL型
+		if height(n.left.left) < height(n.left.right) {
+			n.left = rotateLeft(n.left)
+		}
+		// LL型，右旋
+		return rotateRight(n)
+	}
+	if bf < -1 {
+		// RL型，先对右孩子右旋转换成RR型
+		if height(n.right.right) < height(n.right.left) {
+			n.right = rotateRight(n.right)
+		}
+		// RR型，左旋
+		return rotateLeft(n)
+	}
+	return n
+}
+
+// rotateLeft 左旋，返回新的头节点
+func rotateLeft[T any](n *node[T]) *node[T] {
+	r := n.right
+	n.right = r.left
+	r.left = n
+	update(n)
+	update(r)
+	return r
+}
+
+// rotateRight 右旋，返回新的头节点
+func rotateRight[T any](n *node[T]) *node[T] {
+	l := n.left
+	n.left = l.right
+	l.right = n
+	update(n)
+	update(l)
+	return l
+}
+
+// update 根据左右孩子重新计算高度和大小
+func update[T any](n *node[T]) {
+	lh, rh := height(n.left), height(n.right)
+	if lh > rh {
+		n.height = lh + 1
+	} else {
+		n.height = rh + 1
+	}
+	n.size = size(n.left) + size(n.right) + 1
+}
+
+func height[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func size[T any](n *node[T]) int {
+	if n == nil {
+		return 0
+	}
+	return n.size
+}
@@ -0,0 +1,113 @@
+package avl
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	dstree "github.com/dairongpeng/ds/tree"
+	"github.com/dairongpeng/ds/tree/binarytree"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// toBinaryTree 转换成binarytree.Tree，借助IsBalanced校验AVL性质
+func toBinaryTree[T any](n *node[T]) *binarytree.Node[T] {
+	if n == nil {
+		return nil
+	}
+	return &binarytree.Node[T]{
+		Value: n.value,
+		Left:  toBinaryTree(n.left),
+		Right: toBinaryTree(n.right),
+	}
+}
+
+func TestTree_Balanced(t *testing.T) {
+	var tree dstree.DSTree[int] = New[int](pkg.NumberComparator[int])
+	// 有序插入，普通二叉搜索树会退化成链表
+	for i := 0; i < 1023; i++ {
+		tree.Insert(i)
+	}
+	if tree.Height() != 10 {
+		t.Errorf("Height() = %d, want 10", tree.Height())
+	}
+	bt := &binarytree.Tree[int]{Root: toBinaryTree(tree.(*Tree[int]).root)}
+	if !bt.IsBalanced() {
+		t.Errorf("tree is not balanced")
+	}
+}
+
+func TestTree_OrderStatistics(t *testing.T) {
+	tree := New[int](pkg.NumberComparator[int], 50, 10, 40, 20, 30)
+
+	type testCase struct {
+		name  string
+		value int
+		rank  int
+	}
+	tests := []testCase{
+		{name: "below_min", value: 5, rank: 0},
+		{name: "min", value: 10, rank: 0},
+		{name: "middle", value: 30, rank: 2},
+		{name: "absent", value: 35, rank: 3},
+		{name: "above_max", value: 60, rank: 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tree.Rank(tt.value); got != tt.rank {
+				t.Errorf("Rank(%d) = %d, want %d", tt.value, got, tt.rank)
+			}
+		})
+	}
+
+	for k, want := range []int{10, 20, 30, 40, 50} {
+		if got, ok := tree.Select(k); !ok || got != want {
+			t.Errorf("Select(%d) = %d, %v, want %d", k, got, ok, want)
+		}
+	}
+	if _, ok := tree.Select(5); ok {
+		t.Errorf("Select(5) should be out of range")
+	}
+	if got := tree.RangeCount(15, 40); got != 3 {
+		t.Errorf("RangeCount(15, 40) = %d, want 3", got)
+	}
+	if got := tree.RangeCount(40, 15); got != 0 {
+		t.Errorf("RangeCount(40, 15) = %d, want 0", got)
+	}
+}
+
+func TestTree_Random(t *testing.T) {
+	tree := New[int](pkg.NumberComparator[int])
+	ref := make(map[int]bool)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(1000)
+		if r.Intn(3) == 0 {
+			if ok := tree.Delete(v); ok != ref[v] {
+				t.Fatalf("Delete(%d) = %v, want %v", v, ok, ref[v])
+			}
+			delete(ref, v)
+		} else {
+			tree.Insert(v)
+			ref[v] = true
+		}
+	}
+
+	want := make([]int, 0, len(ref))
+	for v := range ref {
+		want = append(want, v)
+	}
+	sort.Ints(want)
+	if !reflect.DeepEqual(tree.Values(), want) {
+		t.Fatalf("Values() mismatch")
+	}
+	for i, v := range want {
+		if tree.Rank(v) != i {
+			t.Fatalf("Rank(%d) = %d, want %d", v, tree.Rank(v), i)
+		}
+	}
+	bt := &binarytree.Tree[int]{Root: toBinaryTree(tree.root)}
+	if !bt.IsBalanced() {
+		t.Errorf("tree is not balanced")
+	}
+}
@@ -144,6 +144,20 @@ func (t *Tree[T]) FindSuccessorNode(node *Node[T], comparator pkg.Comparator[T])
 	}
 }
 
+// Height 返回二叉树的高度，空树高度为0，只有根节点的树高度为1
+func (t *Tree[T]) Height() int {
+	var f func(node *Node[T]) int
+	f = func(node *Node[T]) int {
+		if node == nil {
+			return 0
+		}
+		// 左右树较大的高度，加上自身节点高度1
+		return int(math.Max(float64(f(node.Left)), float64(f(node.Right)))) + 1
+	}
+
+	return f(t.Root)
+}
+
 // IsBalanced 判断一颗二叉树是不是平衡二叉树
 func (t *Tree[T]) IsBalanced() bool {
 	head := t.Root
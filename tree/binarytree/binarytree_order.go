@@ -275,3 +275,43 @@ func BuildTreeByPreAndInOrder[T any](preorder []T, inorder []T, comparator pkg.C
 
 	return tree
 }
+
+// InOrderIterator 中序遍历的迭代器，和InOrderNonRecursive的思路一致，但每次只弹出一个节点，不需要一次性收集整棵树
+type InOrderIterator[T any] struct {
+	stack *arraystack.Stack[*Node[T]]
+	cur   *Node[T]
+}
+
+// InOrderIterator 返回该二叉树的中序迭代器，迭代期间不能修改树的结构
+func (t *Tree[T]) InOrderIterator() *InOrderIterator[T] {
+	it := &InOrderIterator[T]{
+		stack: arraystack.New[*Node[T]](),
+	}
+	it.pushLeft(t.Root)
+	return it
+}
+
+// Next 移动到中序遍历的下一个节点，没有更多节点时返回false
+func (it *InOrderIterator[T]) Next() bool {
+	node, ok := it.stack.Pop()
+	if !ok {
+		return false
+	}
+	it.cur = node
+	// 弹出节点后，来到该节点的右树，再把右树的左边界依次入栈
+	it.pushLeft(node.Right)
+	return true
+}
+
+// Value 返回当前节点的值
+func (it *InOrderIterator[T]) Value() T {
+	return it.cur.Value
+}
+
+// pushLeft 整条左边界依次入栈
+func (it *InOrderIterator[T]) pushLeft(node *Node[T]) {
+	for node != nil {
+		it.stack.Push(node)
+		node = node.Left
+	}
+}
@@ -0,0 +1,195 @@
+package bst
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	dstree "github.com/dairongpeng/ds/tree"
+	"github.com/dairongpeng/ds/tree/binarytree"
+)
+
+// Tree 二叉搜索树，任意节点左树上的值都比该节点小，右树上的值都比该节点大
+// 底层复用binarytree.Tree保存节点，但不对外暴露节点，避免调用方修改节点破坏有序性和元素个数
+// 不做平衡处理，有序插入时会退化为链表，增删查的时间复杂度为O(h)，h为树的高度
+type Tree[T any] struct {
+	tree binarytree.Tree[T]
+	size int
+	cmp  pkg.Comparator[T]
+}
+
+// New 初始化一个二叉搜索树，comparator决定元素的顺序
+func New[T any](comparator pkg.Comparator[T], values ...T) *Tree[T] {
+	t := &Tree[T]{
+		cmp: comparator,
+	}
+	for _, v := range values {
+		t.Insert(v)
+	}
+	return t
+}
+
+// Insert 插入一个元素，如果已经存在相等的元素则覆盖
+func (t *Tree[T]) Insert(value T) {
+	if t.tree.Root == nil {
+		t.tree.Root = &binarytree.Node[T]{Value: value}
+		t.size++
+		return
+	}
+
+	cur := t.tree.Root
+	for {
+		c := t.cmp(value, cur.Value)
+		if c == 0 { // 已存在，覆盖
+			cur.Value = value
+			return
+		}
+		if c < 0 { // value < cur.Value，去左树
+			if cur.Left == nil {
+				cur.Left = &binarytree.Node[T]{Value: value}
+				t.size++
+				return
+			}
+			cur = cur.Left
+		} else { // value > cur.Value，去右树
+			if cur.Right == nil {
+				cur.Right = &binarytree.Node[T]{Value: value}
+				t.size++
+				return
+			}
+			cur = cur.Right
+		}
+	}
+}
+
+// Delete 删除一个元素，元素不存在时返回false
+func (t *Tree[T]) Delete(value T) bool {
+	var deleted bool
+	t.tree.Root = t.delete(t.tree.Root, value, &deleted)
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// delete 在以node为头的树中删除value，返回删除后新的头节点
+func (t *Tree[T]) delete(node *binarytree.Node[T], value T, deleted *bool) *binarytree.Node[T] {
+	if node == nil {
+		return nil
+	}
+
+	c := t.cmp(value, node.Value)
+	if c < 0 {
+		node.Left = t.delete(node.Left, value, deleted)
+		return node
+	}
+	if c > 0 {
+		node.Right = t.delete(node.Right, value, deleted)
+		return node
+	}
+
+	*deleted = true
+	// 1. 没有左孩子，右孩子顶替
+	if node.Left == nil {
+		return node.Right
+	}
+	// 2. 没有右孩子，左孩子顶替
+	if node.Right == nil {
+		return node.Left
+	}
+	// 3. 左右孩子都有，用后继节点（右树的最左节点）顶替
+	successor := node.Right
+	for successor.Left != nil {
+		successor = successor.Left
+	}
+	node.Value = successor.Value
+	node.Right = t.delete(node.Right, successor.Value, new(bool))
+	return node
+}
+
+// Search 查找元素是否存在
+func (t *Tree[T]) Search(value T) bool {
+	_, ok := t.Get(value)
+	return ok
+}
+
+// Get 查找和value相等的元素并返回
+func (t *Tree[T]) Get(value T) (T, bool) {
+	cur := t.tree.Root
+	for cur != nil {
+		c := t.cmp(value, cur.Value)
+		if c == 0 {
+			return cur.Value, true
+		}
+		if c < 0 {
+			cur = cur.Left
+		} else {
+			cur = cur.Right
+		}
+	}
+	var zeroValue T
+	return zeroValue, false
+}
+
+// Min 返回最小的元素，即整棵树的最左节点
+func (t *Tree[T]) Min() (T, bool) {
+	if t.tree.Root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	cur := t.tree.Root
+	for cur.Left != nil {
+		cur = cur.Left
+	}
+	return cur.Value, true
+}
+
+// Max 返回最大的元素，即整棵树的最右节点
+func (t *Tree[T]) Max() (T, bool) {
+	if t.tree.Root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	cur := t.tree.Root
+	for cur.Right != nil {
+		cur = cur.Right
+	}
+	return cur.Value, true
+}
+
+// Size 返回元素的个数
+func (t *Tree[T]) Size() int {
+	return t.size
+}
+
+// IsEmpty 判断树是否为空
+func (t *Tree[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Height 返回树的高度，空树高度为0
+func (t *Tree[T]) Height() int {
+	return t.tree.Height()
+}
+
+// PreOrder 先序遍历，返回所有元素
+func (t *Tree[T]) PreOrder() []T {
+	return t.tree.PreOrder()
+}
+
+// InOrder 中序遍历，即按从小到大的顺序返回所有元素
+func (t *Tree[T]) InOrder() []T {
+	return t.tree.InOrder()
+}
+
+// PostOrder 后序遍历，返回所有元素
+func (t *Tree[T]) PostOrder() []T {
+	return t.tree.PostOrder()
+}
+
+// LevelOrder 按层遍历，返回所有元素
+func (t *Tree[T]) LevelOrder() []T {
+	return t.tree.LevelOrder()
+}
+
+// Iterator 返回中序迭代器，即按从小到大的顺序访问元素
+func (t *Tree[T]) Iterator() dstree.Iterator[T] {
+	return t.tree.InOrderIterator()
+}
@@ -0,0 +1,100 @@
+package bst
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	dstree "github.com/dairongpeng/ds/tree"
+	"reflect"
+	"testing"
+)
+
+// 测试用的树结构：
+//
+//	   5
+//	 3   8
+//	1 4 7 9
+func newTestTree() *Tree[int] {
+	return New[int](pkg.NumberComparator[int], 5, 3, 8, 1, 4, 7, 9)
+}
+
+func TestTree_InsertSearch(t *testing.T) {
+	var tree dstree.DSTree[int] = newTestTree()
+
+	if tree.Size() != 7 || tree.Height() != 3 {
+		t.Errorf("Size() = %d, Height() = %d", tree.Size(), tree.Height())
+	}
+	tree.Insert(5)
+	if tree.Size() != 7 {
+		t.Errorf("duplicate Insert changed Size() to %d", tree.Size())
+	}
+	if !tree.Search(4) || tree.Search(6) {
+		t.Errorf("Search() mismatch")
+	}
+	if v, ok := tree.Min(); !ok || v != 1 {
+		t.Errorf("Min() = %d, %v", v, ok)
+	}
+	if v, ok := tree.Max(); !ok || v != 9 {
+		t.Errorf("Max() = %d, %v", v, ok)
+	}
+
+	got := make([]int, 0)
+	it := tree.Iterator()
+	for it.Next() {
+		got = append(got, it.Value())
+	}
+	if !reflect.DeepEqual(got, []int{1, 3, 4, 5, 7, 8, 9}) {
+		t.Errorf("Iterator() = %v", got)
+	}
+}
+
+func TestTree_Order(t *testing.T) {
+	tree := newTestTree()
+	if got := tree.PreOrder(); !reflect.DeepEqual(got, []int{5, 3, 1, 4, 8, 7, 9}) {
+		t.Errorf("PreOrder() = %v", got)
+	}
+	if got := tree.PostOrder(); !reflect.DeepEqual(got, []int{1, 4, 3, 7, 9, 8, 5}) {
+		t.Errorf("PostOrder() = %v", got)
+	}
+	if got := tree.LevelOrder(); !reflect.DeepEqual(got, []int{5, 3, 8, 1, 4, 7, 9}) {
+		t.Errorf("LevelOrder() = %v", got)
+	}
+}
+
+func TestTree_Delete(t *testing.T) {
+	type testCase struct {
+		name  string
+		value int
+		ok    bool
+		want  []int
+	}
+	tests := []testCase{
+		{name: "leaf", value: 1, ok: true, want: []int{3, 4, 5, 7, 8, 9}},
+		{name: "two_children", value: 8, ok: true, want: []int{1, 3, 4, 5, 7, 9}},
+		{name: "root", value: 5, ok: true, want: []int{1, 3, 4, 7, 8, 9}},
+		{name: "missing", value: 6, ok: false, want: []int{1, 3, 4, 5, 7, 8, 9}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree := newTestTree()
+			if ok := tree.Delete(tt.value); ok != tt.ok {
+				t.Errorf("Delete(%d) = %v, want %v", tt.value, ok, tt.ok)
+			}
+			// 复用binarytree的中序遍历
+			if got := tree.InOrder(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("InOrder() = %v, want %v", got, tt.want)
+			}
+			if tree.Size() != len(tt.want) {
+				t.Errorf("Size() = %d, want %d", tree.Size(), len(tt.want))
+			}
+		})
+	}
+}
+
+func TestTree_Empty(t *testing.T) {
+	tree := New[int](pkg.NumberComparator[int])
+	if _, ok := tree.Min(); ok {
+		t.Errorf("Min() on empty tree")
+	}
+	if tree.Delete(1) || tree.Height() != 0 || tree.Iterator().Next() {
+		t.Errorf("empty tree mismatch")
+	}
+}
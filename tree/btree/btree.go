@@ -0,0 +1,556 @@
+package btree
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	"github.com/dairongpeng/ds/stack/arraystack"
+	dstree "github.com/dairongpeng/ds/tree"
+	"sort"
+)
+
+// node B树节点，元素有序存放在连续的切片中
+type node[T any] struct {
+	items []T
+	// 叶子节点没有孩子；非叶子节点孩子数等于元素数加一，children[i]中的元素都在items[i-1]和items[i]之间
+	children []*node[T]
+}
+
+func (n *node[T]) leaf() bool {
+	return len(n.children) == 0
+}
+
+// Tree B树，最小度数为degree(t)时满足：
+//  1. 除根节点外，每个节点至少有t-1个元素，根节点至少有1个元素
+//  2. 每个节点至多有2t-1个元素
+//  3. 所有叶子节点在同一层
+//
+// 一个节点存放多个元素，相比二叉树节点少、指针少、内存连续，对CPU缓存更友好
+// 插入时沿途提前分裂满节点，删除时沿途提前补足元素不足的节点，都只需要自顶向下一趟
+type Tree[T any] struct {
+	root   *node[T]
+	degree int
+	size   int
+	cmp    pkg.Comparator[T]
+}
+
+// New 初始化一个最小度数为degree的B树，degree小于2时按2处理（即2-3-4树）
+func New[T any](degree int, comparator pkg.Comparator[T]) *Tree[T] {
+	if degree < 2 {
+		degree = 2
+	}
+	return &Tree[T]{
+		degree: degree,
+		cmp:    comparator,
+	}
+}
+
+// NewFromSorted 使用升序切片批量构建B树，时间复杂度O(N)，比逐个插入更快且节点更满
+// sorted中相等的相邻元素只保留最后一个；如果sorted不是升序的，退化为逐个插入
+func NewFromSorted[T any](degree int, comparator pkg.Comparator[T], sorted []T) *Tree[T] {
+	t := New[T](degree, comparator)
+
+	items := make([]T, 0, len(sorted))
+	for i, v := range sorted {
+		if i > 0 {
+			c := comparator(sorted[i-1], v)
+			if c > 0 { // 不是升序，逐个插入
+				for _, v := range sorted {
+					t.Insert(v)
+				}
+				return t
+			}
+			if c == 0 { // 相等元素保留最后一个
+				items[len(items)-1] = v
+				continue
+			}
+		}
+		items = append(items, v)
+	}
+	if len(items) == 0 {
+		return t
+	}
+
+	// 找到能容纳所有元素的最小高度，高度为h的B树至多容纳(2t)^h-1个元素
+	h := 1
+	for capacity(t.degree, h) < len(items) {
+		h++
+	}
+	t.root = t.build(items, h, true)
+	t.size = len(items)
+	return t
+}
+
+// build 用有序的items构建一棵高度为h的子树
+func (t *Tree[T]) build(items []T, h int, isRoot bool) *node[T] {
+	if h == 1 {
+		return &node[T]{items: append([]T(nil), items...)}
+	}
+
+	// 每个孩子子树加一个分隔元素看作一组，共len(items)+1个位置平均分给c个孩子
+	slots := len(items) + 1
+	c := (slots + capacity(t.degree, h-1)) / (capacity(t.degree, h-1) + 1)
+	// 非根节点至少要有t个孩子
+	if !isRoot && c < t.degree {
+		c = t.degree
+	}
+	base, extra := slots/c, slots%c
+
+	n := &node[T]{
+		items:    make([]T, 0, c-1),
+		children: make([]*node[T], 0, c),
+	}
+	start := 0
+	for j := 0; j < c; j++ {
+		cnt := base - 1
+		if j < extra {
+			cnt++
+		}
+		n.children = append(n.children, t.build(items[start:start+cnt], h-1, false))
+		start += cnt
+		if j < c-1 {
+			n.items = append(n.items, items[start])
+			start++
+		}
+	}
+	return n
+}
+
+// capacity 最小度数为degree、高度为h的B树至多容纳的元素个数(2t)^h-1
+func capacity(degree, h int) int {
+	c := 1
+	for i := 0; i < h; i++ {
+		c *= 2 * degree
+		// 防止溢出，超过int范围已经没有意义
+		if c > 1<<40 {
+			return c
+		}
+	}
+	return c - 1
+}
+
+// Insert 插入一个元素，如果已经存在相等的元素则覆盖
+func (t *Tree[T]) Insert(value T) {
+	if t.root == nil {
+		t.root = &node[T]{items: []T{value}}
+		t.size++
+		return
+	}
+	// 根节点满了，提前分裂，树长高一层
+	if len(t.root.items) == t.maxItems() {
+		old := t.root
+		t.root = &node[T]{children: []*node[T]{old}}
+		t.splitChild(t.root, 0)
+	}
+	if t.insertNonFull(t.root, value) {
+		t.size++
+	}
+}
+
+// insertNonFull 往非满节点n为头的子树中插入value，新增元素返回true，覆盖返回false
+func (t *Tree[T]) insertNonFull(n *node[T], value T) bool {
+	for {
+		i, found := t.find(n.items, value)
+		if found {
+			n.items[i] = value
+			return false
+		}
+		if n.leaf() {
+			n.items = insertAt(n.items, i, value)
+			return true
+		}
+		// 即将进入的孩子满了，提前分裂，保证回溯时不需要再向上分裂
+		if len(n.children[i].items) == t.maxItems() {
+			t.splitChild(n, i)
+			c := t.cmp(value, n.items[i])
+			if c == 0 {
+				n.items[i] = value
+				return false
+			}
+			if c > 0 {
+				i++
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// splitChild 把n的第i个满孩子分裂成两个，中间元素上升到n中
+func (t *Tree[T]) splitChild(n *node[T], i int) {
+	d := t.degree
+	y := n.children[i]
+	mid := y.items[d-1]
+
+	z := &node[T]{items: append([]T(nil), y.items[d:]...)}
+	if !y.leaf() {
+		z.children = append([]*node[T](nil), y.children[d:]...)
+		clearTail(y.children, d)
+		y.children = y.children[:d]
+	}
+	clearTail(y.items, d-1)
+	y.items = y.items[:d-1]
+
+	n.items = insertAt(n.items, i, mid)
+	n.children = insertAt(n.children, i+1, z)
+}
+
+// Delete 删除一个元素，元素不存在时返回false
+func (t *Tree[T]) Delete(value T) bool {
+	if t.root == nil {
+		return false
+	}
+	deleted := t.delete(t.root, value)
+	// 根节点被合并空了，树降低一层
+	if len(t.root.items) == 0 {
+		if t.root.leaf() {
+			t.root = nil
+		} else {
+			t.root = t.root.children[0]
+		}
+	}
+	if deleted {
+		t.size--
+	}
+	return deleted
+}
+
+// delete 从n为头的子树中删除value，调用方保证n是根节点或者至少有t个元素
+func (t *Tree[T]) delete(n *node[T], value T) bool {
+	d := t.degree
+	for {
+		i, found := t.find(n.items, value)
+		if n.leaf() {
+			if found {
+				n.items = removeAt(n.items, i)
+			}
+			return found
+		}
+
+		if found {
+			if len(n.children[i].items) >= d { // 左孩子元素充足，用前驱替换后删除前驱
+				pred := t.maxOf(n.children[i])
+				n.items[i] = pred
+				n, value = n.children[i], pred
+			} else if len(n.children[i+1].items) >= d { // 右孩子元素充足，用后继替换后删除后继
+				succ := t.minOf(n.children[i+1])
+				n.items[i] = succ
+				n, value = n.children[i+1], succ
+			} else { // 左右孩子都只有t-1个元素，合并后在合并的节点中删除
+				t.merge(n, i)
+				n = n.children[i]
+			}
+			continue
+		}
+
+		// 即将进入的孩子只有t-1个元素，先补足
+		if len(n.children[i].items) == d-1 {
+			if i > 0 && len(n.children[i-1].items) >= d {
+				t.borrowFromLeft(n, i)
+			} else if i < len(n.children)-1 && len(n.children[i+1].items) >= d {
+				t.borrowFromRight(n, i)
+			} else {
+				if i == len(n.children)-1 {
+					i--
+				}
+				t.merge(n, i)
+			}
+		}
+		n = n.children[i]
+	}
+}
+
+// merge 把n的第i个元素和第i+1个孩子合并进第i个孩子
+func (t *Tree[T]) merge(n *node[T], i int) {
+	left, right := n.children[i], n.children[i+1]
+	left.items = append(left.items, n.items[i])
+	left.items = append(left.items, right.items...)
+	left.children = append(left.children, right.children...)
+	n.items = removeAt(n.items, i)
+	n.children = removeAt(n.children, i+1)
+}
+
+// borrowFromLeft 第i个孩子从左兄弟借一个元素：父元素下降，左兄弟最大元素上升
+func (t *Tree[T]) borrowFromLeft(n *node[T], i int) {
+	child, left := n.children[i], n.children[i-1]
+	child.items = insertAt(child.items, 0, n.items[i-1])
+	n.items[i-1] = left.items[len(left.items)-1]
+	left.items = removeAt(left.items, len(left.items)-1)
+	if !left.leaf() {
+		child.children = insertAt(child.children, 0, left.children[len(left.children)-1])
+		left.children = removeAt(left.children, len(left.children)-1)
+	}
+}
+
+// borrowFromRight 第i个孩子从右兄弟借一个元素：父元素下降，右兄弟最小元素上升
+func (t *Tree[T]) borrowFromRight(n *node[T], i int) {
+	child, right := n.children[i], n.children[i+1]
+	child.items = append(child.items, n.items[i])
+	n.items[i] = right.items[0]
+	right.items = removeAt(right.items, 0)
+	if !right.leaf() {
+		child.children = append(child.children, right.children[0])
+		right.children = removeAt(right.children, 0)
+	}
+}
+
+// Get 查找和value相等的元素并返回
+func (t *Tree[T]) Get(value T) (T, bool) {
+	n := t.root
+	for n != nil {
+		i, found := t.find(n.items, value)
+		if found {
+			return n.items[i], true
+		}
+		if n.leaf() {
+			break
+		}
+		n = n.children[i]
+	}
+	var zeroValue T
+	return zeroValue, false
+}
+
+// Search 查找元素是否存在
+func (t *Tree[T]) Search(value T) bool {
+	_, ok := t.Get(value)
+	return ok
+}
+
+// Min 返回最小的元素
+func (t *Tree[T]) Min() (T, bool) {
+	if t.root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return t.minOf(t.root), true
+}
+
+// Max 返回最大的元素
+func (t *Tree[T]) Max() (T, bool) {
+	if t.root == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return t.maxOf(t.root), true
+}
+
+// Size 返回元素的个数
+func (t *Tree[T]) Size() int {
+	return t.size
+}
+
+// IsEmpty 判断树是否为空
+func (t *Tree[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Degree 返回最小度数
+func (t *Tree[T]) Degree() int {
+	return t.degree
+}
+
+// Height 返回树的高度，空树高度为0。所有叶子在同一层，沿最左路径即可
+func (t *Tree[T]) Height() int {
+	h := 0
+	for n := t.root; n != nil; {
+		h++
+		if n.leaf() {
+			break
+		}
+		n = n.children[0]
+	}
+	return h
+}
+
+// Clear 清空树
+func (t *Tree[T]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Ascend 按从小到大的顺序遍历所有元素，f返回false时提前终止遍历
+func (t *Tree[T]) Ascend(f func(value T) bool) {
+	if t.root != nil {
+		var zeroValue T
+		t.ascend(t.root, zeroValue, zeroValue, false, false, f)
+	}
+}
+
+// AscendRange 按从小到大的顺序遍历闭区间[lo, hi]内的元素，f返回false时提前终止遍历。lo大于hi时不遍历任何元素
+func (t *Tree[T]) AscendRange(lo, hi T, f func(value T) bool) {
+	if t.root != nil && t.cmp(lo, hi) <= 0 {
+		t.ascend(t.root, lo, hi, true, true, f)
+	}
+}
+
+// Descend 按从大到小的顺序遍历所有元素，f返回false时提前终止遍历
+func (t *Tree[T]) Descend(f func(value T) bool) {
+	if t.root != nil {
+		var zeroValue T
+		t.descend(t.root, zeroValue, zeroValue, false, false, f)
+	}
+}
+
+// DescendRange 按从大到小的顺序遍历闭区间[lo, hi]内的元素，f返回false时提前终止遍历。lo大于hi时不遍历任何元素
+// 参数顺序与AscendRange一致，都是先lo后hi
+func (t *Tree[T]) DescendRange(lo, hi T, f func(value T) bool) {
+	if t.root != nil && t.cmp(lo, hi) <= 0 {
+		t.descend(t.root, lo, hi, true, true, f)
+	}
+}
+
+// ascend 中序遍历n为头的子树，跳过小于lo的孩子，遇到大于hi的元素终止。返回false表示终止整个遍历
+func (t *Tree[T]) ascend(n *node[T], lo, hi T, hasLo, hasHi bool, f func(value T) bool) bool {
+	start := 0
+	if hasLo {
+		// 第一个大于等于lo的元素，它左侧的孩子都小于lo
+		start, _ = t.find(n.items, lo)
+	}
+	for i := start; i < len(n.items); i++ {
+		if !n.leaf() && !t.ascend(n.children[i], lo, hi, hasLo, hasHi, f) {
+			return false
+		}
+		if hasHi && t.cmp(n.items[i], hi) > 0 {
+			return false
+		}
+		if !f(n.items[i]) {
+			return false
+		}
+	}
+	if !n.leaf() {
+		return t.ascend(n.children[len(n.items)], lo, hi, hasLo, hasHi, f)
+	}
+	return true
+}
+
+// descend 逆中序遍历n为头的子树，跳过大于hi的孩子，遇到小于lo的元素终止。返回false表示终止整个遍历
+func (t *Tree[T]) descend(n *node[T], lo, hi T, hasLo, hasHi bool, f func(value T) bool) bool {
+	end := len(n.items) - 1
+	if hasHi {
+		// 最后一个小于等于hi的元素，它右侧的孩子都大于hi
+		i, found := t.find(n.items, hi)
+		if found {
+			end = i
+		} else {
+			end = i - 1
+		}
+	}
+	for i := end; i >= 0; i-- {
+		if !n.leaf() && !t.descend(n.children[i+1], lo, hi, hasLo, hasHi, f) {
+			return false
+		}
+		if hasLo && t.cmp(n.items[i], lo) < 0 {
+			return false
+		}
+		if !f(n.items[i]) {
+			return false
+		}
+	}
+	if !n.leaf() {
+		return t.descend(n.children[0], lo, hi, hasLo, hasHi, f)
+	}
+	return true
+}
+
+// Iterator 返回中序迭代器，即按从小到大的顺序访问元素
+func (t *Tree[T]) Iterator() dstree.Iterator[T] {
+	it := &iterator[T]{
+		stack: arraystack.New[*frame[T]](),
+	}
+	it.pushLeft(t.root)
+	return it
+}
+
+// frame 迭代器栈帧，记录节点以及下一个要访问的元素下标
+type frame[T any] struct {
+	n *node[T]
+	i int
+}
+
+// iterator 借助栈实现的中序迭代器
+type iterator[T any] struct {
+	stack *arraystack.Stack[*frame[T]]
+	cur   T
+}
+
+func (it *iterator[T]) Next() bool {
+	for {
+		top, ok := it.stack.Top()
+		if !ok {
+			return false
+		}
+		if top.i < len(top.n.items) {
+			it.cur = top.n.items[top.i]
+			top.i++
+			// 访问完items[i]后，接下来访问children[i+1]的最左路径
+			if !top.n.leaf() {
+				it.pushLeft(top.n.children[top.i])
+			}
+			return true
+		}
+		it.stack.Pop()
+	}
+}
+
+func (it *iterator[T]) Value() T {
+	return it.cur
+}
+
+func (it *iterator[T]) pushLeft(n *node[T]) {
+	for n != nil {
+		it.stack.Push(&frame[T]{n: n})
+		if n.leaf() {
+			return
+		}
+		n = n.children[0]
+	}
+}
+
+// find 在有序的items中二分查找第一个大于等于value的位置，以及该位置是否等于value
+func (t *Tree[T]) find(items []T, value T) (int, bool) {
+	i := sort.Search(len(items), func(i int) bool {
+		return t.cmp(items[i], value) >= 0
+	})
+	return i, i < len(items) && t.cmp(items[i], value) == 0
+}
+
+func (t *Tree[T]) minOf(n *node[T]) T {
+	for !n.leaf() {
+		n = n.children[0]
+	}
+	return n.items[0]
+}
+
+func (t *Tree[T]) maxOf(n *node[T]) T {
+	for !n.leaf() {
+		n = n.children[len(n.children)-1]
+	}
+	return n.items[len(n.items)-1]
+}
+
+func (t *Tree[T]) maxItems() int {
+	return 2*t.degree - 1
+}
+
+// insertAt 在s的第i个位置插入v
+func insertAt[E any](s []E, i int, v E) []E {
+	var zeroValue E
+	s = append(s, zeroValue)
+	copy(s[i+1:], s[i:])
+	s[i] = v
+	return s
+}
+
+// removeAt 删除s的第i个元素，并清空尾部引用便于GC
+func removeAt[E any](s []E, i int) []E {
+	copy(s[i:], s[i+1:])
+	var zeroValue E
+	s[len(s)-1] = zeroValue
+	return s[:len(s)-1]
+}
+
+// clearTail 清空s[from:]的引用便于GC
+func clearTail[E any](s []E, from int) {
+	var zeroValue E
+	for i := from; i < len(s); i++ {
+		s[i] = zeroValue
+	}
+}
@@ -0,0 +1,164 @@
+package btree
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	dstree "github.com/dairongpeng/ds/tree"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// check 校验B树的性质，返回叶子所在的深度
+func check[T any](t *testing.T, tree *Tree[T], n *node[T], isRoot bool) int {
+	d := tree.degree
+	if len(n.items) > 2*d-1 || (!isRoot && len(n.items) < d-1) || len(n.items) == 0 {
+		t.Fatalf("node has %d items, degree %d", len(n.items), d)
+	}
+	for i := 1; i < len(n.items); i++ {
+		if tree.cmp(n.items[i-1], n.items[i]) >= 0 {
+			t.Fatalf("items out of order")
+		}
+	}
+	if n.leaf() {
+		return 1
+	}
+	if len(n.children) != len(n.items)+1 {
+		t.Fatalf("node has %d items but %d children", len(n.items), len(n.children))
+	}
+	depth := -1
+	for _, c := range n.children {
+		cd := check(t, tree, c, false)
+		if depth != -1 && cd != depth {
+			t.Fatalf("leaves at different depth")
+		}
+		depth = cd
+	}
+	return depth + 1
+}
+
+func collect[T any](tree *Tree[T]) []T {
+	values := make([]T, 0, tree.Size())
+	it := tree.Iterator()
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+func TestTree_Random(t *testing.T) {
+	for _, degree := range []int{2, 3, 16} {
+		var tree dstree.DSTree[int] = New[int](degree, pkg.NumberComparator[int])
+		ref := make(map[int]bool)
+		r := rand.New(rand.NewSource(int64(degree)))
+		for i := 0; i < 10000; i++ {
+			v := r.Intn(2000)
+			if r.Intn(3) == 0 {
+				if ok := tree.Delete(v); ok != ref[v] {
+					t.Fatalf("degree %d: Delete(%d) = %v, want %v", degree, v, ok, ref[v])
+				}
+				delete(ref, v)
+			} else {
+				tree.Insert(v)
+				ref[v] = true
+			}
+		}
+
+		bt := tree.(*Tree[int])
+		if bt.root != nil && check(t, bt, bt.root, true) != tree.Height() {
+			t.Fatalf("degree %d: Height() mismatch", degree)
+		}
+		want := make([]int, 0, len(ref))
+		for v := range ref {
+			want = append(want, v)
+		}
+		sort.Ints(want)
+		if tree.Size() != len(want) || !reflect.DeepEqual(collect(bt), want) {
+			t.Fatalf("degree %d: iteration mismatch", degree)
+		}
+	}
+}
+
+func TestTree_Range(t *testing.T) {
+	tree := New[int](2, pkg.NumberComparator[int])
+	for i := 1; i <= 20; i++ {
+		tree.Insert(i * 10)
+	}
+
+	asc := make([]int, 0)
+	tree.AscendRange(45, 90, func(v int) bool {
+		asc = append(asc, v)
+		return true
+	})
+	if !reflect.DeepEqual(asc, []int{50, 60, 70, 80, 90}) {
+		t.Errorf("AscendRange(45, 90) = %v", asc)
+	}
+
+	desc := make([]int, 0)
+	tree.DescendRange(45, 90, func(v int) bool {
+		desc = append(desc, v)
+		return true
+	})
+	if !reflect.DeepEqual(desc, []int{90, 80, 70, 60, 50}) {
+		t.Errorf("DescendRange(45, 90) = %v", desc)
+	}
+
+	// lo大于hi时两个方向都不遍历任何元素
+	tree.AscendRange(90, 45, func(v int) bool {
+		t.Errorf("AscendRange(90, 45) visited %d", v)
+		return true
+	})
+	tree.DescendRange(90, 45, func(v int) bool {
+		t.Errorf("DescendRange(90, 45) visited %d", v)
+		return true
+	})
+
+	// 提前终止
+	first := make([]int, 0)
+	tree.Descend(func(v int) bool {
+		first = append(first, v)
+		return len(first) < 3
+	})
+	if !reflect.DeepEqual(first, []int{200, 190, 180}) {
+		t.Errorf("Descend() = %v", first)
+	}
+	if min, _ := tree.Min(); min != 10 {
+		t.Errorf("Min() = %d", min)
+	}
+	if max, _ := tree.Max(); max != 200 {
+		t.Errorf("Max() = %d", max)
+	}
+}
+
+func TestNewFromSorted(t *testing.T) {
+	for _, degree := range []int{2, 3, 5} {
+		for n := 0; n < 300; n++ {
+			sorted := make([]int, n)
+			for i := range sorted {
+				sorted[i] = i
+			}
+			tree := NewFromSorted[int](degree, pkg.NumberComparator[int], sorted)
+			if tree.root != nil {
+				check(t, tree, tree.root, true)
+			}
+			if tree.Size() != n || !reflect.DeepEqual(collect(tree), sorted) {
+				t.Fatalf("degree %d n %d: content mismatch", degree, n)
+			}
+			// 批量构建后依然可以正常增删
+			tree.Insert(-1)
+			tree.Delete(n / 2)
+			if tree.root != nil {
+				check(t, tree, tree.root, true)
+			}
+		}
+	}
+
+	tree := NewFromSorted[int](2, pkg.NumberComparator[int], []int{1, 2, 2, 3})
+	if !reflect.DeepEqual(collect(tree), []int{1, 2, 3}) {
+		t.Errorf("duplicates not merged: %v", collect(tree))
+	}
+	tree = NewFromSorted[int](2, pkg.NumberComparator[int], []int{3, 1, 2})
+	if !reflect.DeepEqual(collect(tree), []int{1, 2, 3}) {
+		t.Errorf("unsorted input: %v", collect(tree))
+	}
+}
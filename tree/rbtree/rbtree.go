@@ -0,0 +1,534 @@
+package rbtree
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	dstree "github.com/dairongpeng/ds/tree"
+)
+
+const (
+	red   = true
+	black = false
+)
+
+// node 红黑树节点
+type node[T any] struct {
+	value T
+	// 节点颜色，true为红，false为黑
+	color bool
+	// 左孩子
+	left *node[T]
+	// 右孩子
+	right *node[T]
+	// 父节点，维护父指针后迭代时无需借助栈，也无需提前收集元素
+	parent *node[T]
+}
+
+// Tree 红黑树，通过节点颜色约束树的高度，增删查的时间复杂度都是O(logN)
+//  1. 每个节点要么是红色，要么是黑色
+//  2. 根节点是黑色
+//  3. 叶子节点（nil）是黑色
+//  4. 红色节点的孩子一定是黑色
+//  5. 任意节点到其所有后代叶子节点的路径上，黑色节点的数量相同
+//
+// 相比AVL树，红黑树的平衡要求更宽松，插入删除时旋转次数更少，适合写多的场景
+type Tree[T any] struct {
+	root *node[T]
+	size int
+	cmp  pkg.Comparator[T]
+}
+
+// New 初始化一个红黑树，comparator决定元素的顺序
+func New[T any](comparator pkg.Comparator[T], values ...T) *Tree[T] {
+	t := &Tree[T]{
+		cmp: comparator,
+	}
+	for _, v := range values {
+		t.Insert(v)
+	}
+	return t
+}
+
+// Insert 插入一个元素，如果已经存在相等的元素则覆盖
+func (t *Tree[T]) Insert(value T) {
+	var parent *node[T]
+	cur := t.root
+	c := 0
+	for cur != nil {
+		parent = cur
+		c = t.cmp(value, cur.value)
+		if c < 0 {
+			cur = cur.left
+		} else if c > 0 {
+			cur = cur.right
+		} else {
+			cur.value = value
+			return
+		}
+	}
+
+	n := &node[T]{value: value, color: red, parent: parent}
+	if parent == nil {
+		t.root = n
+	} else if c < 0 {
+		parent.left = n
+	} else {
+		parent.right = n
+	}
+	t.size++
+	t.fixAfterInsert(n)
+}
+
+// Delete 删除一个元素，元素不存在时返回false
+func (t *Tree[T]) Delete(value T) bool {
+	n := t.getNode(value)
+	if n == nil {
+		return false
+	}
+	t.deleteNode(n)
+	return true
+}
+
+// Search 查找元素是否存在
+func (t *Tree[T]) Search(value T) bool {
+	return t.getNode(value) != nil
+}
+
+// Get 查找和value相等的元素并返回，comparator只比较部分字段时，可以用来取回完整的元素
+func (t *Tree[T]) Get(value T) (T, bool) {
+	return valueOf(t.getNode(value))
+}
+
+// Min 返回最小的元素
+func (t *Tree[T]) Min() (T, bool) {
+	return valueOf(t.first())
+}
+
+// Max 返回最大的元素
+func (t *Tree[T]) Max() (T, bool) {
+	n := t.root
+	if n == nil {
+		return valueOf(n)
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return valueOf(n)
+}
+
+// Floor 返回小于等于value的最大元素，不存在时返回false
+func (t *Tree[T]) Floor(value T) (T, bool) {
+	return valueOf(t.floorNode(value))
+}
+
+// Ceiling 返回大于等于value的最小元素，不存在时返回false
+func (t *Tree[T]) Ceiling(value T) (T, bool) {
+	return valueOf(t.ceilingNode(value))
+}
+
+// Size 返回元素的个数
+func (t *Tree[T]) Size() int {
+	return t.size
+}
+
+// IsEmpty 判断树是否为空
+func (t *Tree[T]) IsEmpty() bool {
+	return t.size == 0
+}
+
+// Height 返回树的高度，空树高度为0
+func (t *Tree[T]) Height() int {
+	var f func(n *node[T]) int
+	f = func(n *node[T]) int {
+		if n == nil {
+			return 0
+		}
+		lh, rh := f(n.left), f(n.right)
+		if lh > rh {
+			return lh + 1
+		}
+		return rh + 1
+	}
+	return f(t.root)
+}
+
+// Clear 清空树
+func (t *Tree[T]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// Iterator 返回中序迭代器，即按从小到大的顺序访问所有元素
+func (t *Tree[T]) Iterator() dstree.Iterator[T] {
+	return &Iterator[T]{next: t.first()}
+}
+
+// Range 返回闭区间[lo, hi]内元素的有序迭代器
+// 迭代器沿父指针寻找后继节点，不会分配切片，单步均摊O(1)，定位起点O(logN)
+func (t *Tree[T]) Range(lo, hi T) *Iterator[T] {
+	it := &Iterator[T]{
+		hi:    hi,
+		bound: true,
+		cmp:   t.cmp,
+	}
+	if t.cmp(lo, hi) <= 0 {
+		it.next = t.ceilingNode(lo)
+	}
+	return it
+}
+
+// Iterator 红黑树的有序迭代器，迭代期间不能修改树
+type Iterator[T any] struct {
+	next *node[T]
+	cur  *node[T]
+	// 是否有上界
+	bound bool
+	hi    T
+	cmp   pkg.Comparator[T]
+}
+
+// Next 移动到下一个元素，没有更多元素时返回false
+func (it *Iterator[T]) Next() bool {
+	if it.next == nil || (it.bound && it.cmp(it.next.value, it.hi) > 0) {
+		it.next = nil
+		return false
+	}
+	it.cur = it.next
+	it.next = successor(it.next)
+	return true
+}
+
+// Value 返回当前位置的元素
+func (it *Iterator[T]) Value() T {
+	return it.cur.value
+}
+
+// getNode 二分查找value所在的节点，不存在返回nil
+func (t *Tree[T]) getNode(value T) *node[T] {
+	cur := t.root
+	for cur != nil {
+		c := t.cmp(value, cur.value)
+		if c < 0 {
+			cur = cur.left
+		} else if c > 0 {
+			cur = cur.right
+		} else {
+			return cur
+		}
+	}
+	return nil
+}
+
+// floorNode 小于等于value的最大节点
+func (t *Tree[T]) floorNode(value T) *node[T] {
+	var found *node[T]
+	cur := t.root
+	for cur != nil {
+		c := t.cmp(value, cur.value)
+		if c == 0 {
+			return cur
+		}
+		if c < 0 {
+			cur = cur.left
+		} else {
+			found = cur
+			cur = cur.right
+		}
+	}
+	return found
+}
+
+// ceilingNode 大于等于value的最小节点
+func (t *Tree[T]) ceilingNode(value T) *node[T] {
+	var found *node[T]
+	cur := t.root
+	for cur != nil {
+		c := t.cmp(value, cur.value)
+		if c == 0 {
+			return cur
+		}
+		if c > 0 {
+			cur = cur.right
+		} else {
+			found = cur
+			cur = cur.left
+		}
+	}
+	return found
+}
+
+// first 整棵树的最左节点，即最小节点
+func (t *Tree[T]) first() *node[T] {
+	n := t.root
+	if n == nil {
+		return nil
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+// deleteNode 删除节点n并重新平衡
+func (t *Tree[T]) deleteNode(n *node[T]) {
+	t.size--
+
+	// n有左右两个孩子，用后继节点的值替换n，转而删除后继节点
+	if n.left != nil && n.right != nil {
+		s := successor(n)
+		n.value = s.value
+		n = s
+	}
+
+	var replacement *node[T]
+	if n.left != nil {
+		replacement = n.left
+	} else {
+		replacement = n.right
+	}
+
+	if replacement != nil {
+		replacement.parent = n.parent
+		if n.parent == nil {
+			t.root = replacement
+		} else if n == n.parent.left {
+			n.parent.left = replacement
+		} else {
+			n.parent.right = replacement
+		}
+		n.left, n.right, n.parent = nil, nil, nil
+		if n.color == black {
+			t.fixAfterDelete(replacement)
+		}
+	} else if n.parent == nil {
+		t.root = nil
+	} else {
+		// n没有孩子，先把n当作虚拟的叶子进行调整，再摘除
+		if n.color == black {
+			t.fixAfterDelete(n)
+		}
+		if n.parent != nil {
+			if n == n.parent.left {
+				n.parent.left = nil
+			} else if n == n.parent.right {
+				n.parent.right = nil
+			}
+			n.parent = nil
+		}
+	}
+}
+
+// fixAfterInsert 新插入的红色节点x可能破坏性质4，向上调整
+func (t *Tree[T]) fixAfterInsert(x *node[T]) {
+	for x != nil && x != t.root && x.parent.color == red {
+		if parentOf(x) == leftOf(parentOf(parentOf(x))) {
+			// 叔叔节点
+			y := rightOf(parentOf(parentOf(x)))
+			if colorOf(y) == red { // 叔叔是红色，父亲和叔叔变黑，爷爷变红，问题上移到爷爷
+				setColor(parentOf(x), black)
+				setColor(y, black)
+				setColor(parentOf(parentOf(x)), red)
+				x = parentOf(parentOf(x))
+			} else { // 叔叔是黑色，通过旋转解决
+				if x == rightOf(parentOf(x)) { // LR型，先左旋成LL型
+					x = parentOf(x)
+					t.rotateLeft(x)
+				}
+				setColor(parentOf(x), black)
+				setColor(parentOf(parentOf(x)), red)
+				t.rotateRight(parentOf(parentOf(x)))
+			}
+		} else {
+			y := leftOf(parentOf(parentOf(x)))
+			if colorOf(y) == red {
+				setColor(parentOf(x), black)
+				setColor(y, black)
+				setColor(parentOf(parentOf(x)), red)
+				x = parentOf(parentOf(x))
+			} else {
+				if x == leftOf(parentOf(x)) { // RL型，先右旋成RR型
+					x = parentOf(x)
+					t.rotateRight(x)
+				}
+				setColor(parentOf(x), black)
+				setColor(parentOf(parentOf(x)), red)
+				t.rotateLeft(parentOf(parentOf(x)))
+			}
+		}
+	}
+	t.root.color = black
+}
+
+// fixAfterDelete x所在路径少了一个黑色节点，通过变色和旋转补齐
+func (t *Tree[T]) fixAfterDelete(x *node[T]) {
+	for x != t.root && colorOf(x) == black {
+		if x == leftOf(parentOf(x)) {
+			sib := rightOf(parentOf(x))
+			// 兄弟是红色，转换成兄弟是黑色的情况
+			if colorOf(sib) == red {
+				setColor(sib, black)
+				setColor(parentOf(x), red)
+				t.rotateLeft(parentOf(x))
+				sib = rightOf(parentOf(x))
+			}
+
+			if colorOf(leftOf(sib)) == black && colorOf(rightOf(sib)) == black {
+				// 兄弟的孩子都是黑色，兄弟变红，问题上移到父亲
+				setColor(sib, red)
+				x = parentOf(x)
+			} else {
+				// 兄弟的远侄子是黑色，先旋转使远侄子变为红色
+				if colorOf(rightOf(sib)) == black {
+					setColor(leftOf(sib), black)
+					setColor(sib, red)
+					t.rotateRight(sib)
+					sib = rightOf(parentOf(x))
+				}
+				setColor(sib, colorOf(parentOf(x)))
+				setColor(parentOf(x), black)
+				setColor(rightOf(sib), black)
+				t.rotateLeft(parentOf(x))
+				x = t.root
+			}
+		} else {
+			sib := leftOf(parentOf(x))
+			if colorOf(sib) == red {
+				setColor(sib, black)
+				setColor(parentOf(x), red)
+				t.rotateRight(parentOf(x))
+				sib = leftOf(parentOf(x))
+			}
+
+			if colorOf(rightOf(sib)) == black && colorOf(leftOf(sib)) == black {
+				setColor(sib, red)
+				x = parentOf(x)
+			} else {
+				if colorOf(leftOf(sib)) == black {
+					setColor(rightOf(sib), black)
+					setColor(sib, red)
+					t.rotateLeft(sib)
+					sib = leftOf(parentOf(x))
+				}
+				setColor(sib, colorOf(parentOf(x)))
+				setColor(parentOf(x), black)
+				setColor(leftOf(sib), black)
+				t.rotateRight(parentOf(x))
+				x = t.root
+			}
+		}
+	}
+	setColor(x, black)
+}
+
+// rotateLeft 以p为支点左旋
+//
+//	  p                r
+//	a   r     =>     p   c
+//	   b c          a b
+func (t *Tree[T]) rotateLeft(p *node[T]) {
+	if p == nil {
+		return
+	}
+	r := p.right
+	p.right = r.left
+	if r.left != nil {
+		r.left.parent = p
+	}
+	r.parent = p.parent
+	if p.parent == nil {
+		t.root = r
+	} else if p.parent.left == p {
+		p.parent.left = r
+	} else {
+		p.parent.right = r
+	}
+	r.left = p
+	p.parent = r
+}
+
+// rotateRight 以p为支点右旋
+//
+//	   p            l
+//	 l   c   =>   a   p
+//	a b              b c
+func (t *Tree[T]) rotateRight(p *node[T]) {
+	if p == nil {
+		return
+	}
+	l := p.left
+	p.left = l.right
+	if l.right != nil {
+		l.right.parent = p
+	}
+	l.parent = p.parent
+	if p.parent == nil {
+		t.root = l
+	} else if p.parent.right == p {
+		p.parent.right = l
+	} else {
+		p.parent.left = l
+	}
+	l.right = p
+	p.parent = l
+}
+
+// successor 中序遍历中n的下一个节点
+func successor[T any](n *node[T]) *node[T] {
+	if n.right != nil {
+		p := n.right
+		for p.left != nil {
+			p = p.left
+		}
+		return p
+	}
+	p := n.parent
+	ch := n
+	for p != nil && ch == p.right {
+		ch = p
+		p = p.parent
+	}
+	return p
+}
+
+func valueOf[T any](n *node[T]) (T, bool) {
+	if n == nil {
+		var zeroValue T
+		return zeroValue, false
+	}
+	return n.value, true
+}
+
+// 以下辅助函数对nil安全，nil节点视为黑色叶子
+
+func colorOf[T any](n *node[T]) bool {
+	if n == nil {
+		return black
+	}
+	return n.color
+}
+
+func setColor[T any](n *node[T], c bool) {
+	if n != nil {
+		n.color = c
+	}
+}
+
+func parentOf[T any](n *node[T]) *node[T] {
+	if n == nil {
+		return nil
+	}
+	return n.parent
+}
+
+func leftOf[T any](n *node[T]) *node[T] {
+	if n == nil {
+		return nil
+	}
+	return n.left
+}
+
+func rightOf[T any](n *node[T]) *node[T] {
+	if n == nil {
+		return nil
+	}
+	return n.right
+}
@@ -0,0 +1,121 @@
+package rbtree
+
+import (
+	"github.com/dairongpeng/ds/pkg"
+	dstree "github.com/dairongpeng/ds/tree"
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// checkRB 校验红黑树的性质，返回黑高
+func checkRB[T any](t *testing.T, tree *Tree[T], n *node[T]) int {
+	if n == nil {
+		return 1
+	}
+	if n.color == red && (colorOf(n.left) == red || colorOf(n.right) == red) {
+		t.Fatalf("red node %v has red child", n.value)
+	}
+	if n.left != nil && (n.left.parent != n || tree.cmp(n.left.value, n.value) >= 0) {
+		t.Fatalf("bad left child of %v", n.value)
+	}
+	if n.right != nil && (n.right.parent != n || tree.cmp(n.right.value, n.value) <= 0) {
+		t.Fatalf("bad right child of %v", n.value)
+	}
+	lh := checkRB(t, tree, n.left)
+	rh := checkRB(t, tree, n.right)
+	if lh != rh {
+		t.Fatalf("black height mismatch at %v", n.value)
+	}
+	if n.color == black {
+		return lh + 1
+	}
+	return lh
+}
+
+func collect[T any](it dstree.Iterator[T]) []T {
+	values := make([]T, 0)
+	for it.Next() {
+		values = append(values, it.Value())
+	}
+	return values
+}
+
+func TestTree_Range(t *testing.T) {
+	tree := New[int](pkg.NumberComparator[int], 10, 20, 30, 40, 50)
+
+	type testCase struct {
+		name   string
+		lo, hi int
+		want   []int
+	}
+	tests := []testCase{
+		{name: "inner", lo: 15, hi: 45, want: []int{20, 30, 40}},
+		{name: "exact_bounds", lo: 20, hi: 40, want: []int{20, 30, 40}},
+		{name: "all", lo: 0, hi: 100, want: []int{10, 20, 30, 40, 50}},
+		{name: "single", lo: 30, hi: 30, want: []int{30}},
+		{name: "empty_gap", lo: 31, hi: 39, want: []int{}},
+		{name: "reversed", lo: 40, hi: 20, want: []int{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := collect[int](tree.Range(tt.lo, tt.hi)); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Range(%d, %d) = %v, want %v", tt.lo, tt.hi, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTree_FloorCeiling(t *testing.T) {
+	tree := New[int](pkg.NumberComparator[int], 10, 20, 30)
+	if v, ok := tree.Floor(25); !ok || v != 20 {
+		t.Errorf("Floor(25) = %d, %v", v, ok)
+	}
+	if _, ok := tree.Floor(5); ok {
+		t.Errorf("Floor(5) should not exist")
+	}
+	if v, ok := tree.Ceiling(25); !ok || v != 30 {
+		t.Errorf("Ceiling(25) = %d, %v", v, ok)
+	}
+	if _, ok := tree.Ceiling(35); ok {
+		t.Errorf("Ceiling(35) should not exist")
+	}
+	if v, ok := tree.Get(20); !ok || v != 20 {
+		t.Errorf("Get(20) = %d, %v", v, ok)
+	}
+}
+
+func TestTree_Random(t *testing.T) {
+	var tree dstree.DSTree[int] = New[int](pkg.NumberComparator[int])
+	ref := make(map[int]bool)
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 5000; i++ {
+		v := r.Intn(1000)
+		if r.Intn(3) == 0 {
+			if ok := tree.Delete(v); ok != ref[v] {
+				t.Fatalf("Delete(%d) = %v, want %v", v, ok, ref[v])
+			}
+			delete(ref, v)
+		} else {
+			tree.Insert(v)
+			ref[v] = true
+		}
+	}
+	checkRB(t, tree.(*Tree[int]), tree.(*Tree[int]).root)
+
+	want := make([]int, 0, len(ref))
+	for v := range ref {
+		want = append(want, v)
+	}
+	sort.Ints(want)
+	if tree.Size() != len(want) || !reflect.DeepEqual(collect(tree.Iterator()), want) {
+		t.Fatalf("iteration mismatch")
+	}
+	if min, _ := tree.Min(); min != want[0] {
+		t.Errorf("Min() = %d, want %d", min, want[0])
+	}
+	if max, _ := tree.Max(); max != want[len(want)-1] {
+		t.Errorf("Max() = %d, want %d", max, want[len(want)-1])
+	}
+}
@@ -1,4 +1,19 @@
 package dstree
 
+// DSTree 搜索树结构，元素的顺序由比较器决定，相等的元素只保留一个
 type DSTree[T any] interface {
+	Insert(value T)
+	Delete(value T) bool
+	Search(value T) bool
+	Min() (T, bool)
+	Max() (T, bool)
+	Size() int
+	Height() int
+	Iterator() Iterator[T]
+}
+
+// Iterator 树的中序迭代器，按从小到大的顺序访问元素
+type Iterator[T any] interface {
+	Next() bool
+	Value() T
 }